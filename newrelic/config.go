@@ -2,14 +2,20 @@ package newrelic
 
 import (
 	"compress/gzip"
+	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/mitchellh/go-homedir"
@@ -34,6 +40,7 @@ type Config struct {
 	InsightsInsertURL    string
 	InsightsQueryKey     string
 	InsightsQueryURL     string
+	LogLevel             string
 	NerdGraphAPIURL      string
 	SyntheticsAPIURL     string
 	userAgent            string
@@ -71,8 +78,17 @@ func (c *Config) Client() (*nr.NewRelic, error) {
 		t = &http.Transport{TLSClientConfig: tlsCfg}
 	}
 
+	// The client's log level follows TF_LOG unless set explicitly.
+	logLevel := c.LogLevel
+	if logLevel == "" {
+		logLevel = logging.LogLevel()
+	}
+
+	if logLevel != "" {
+		options = append(options, nr.ConfigLogLevel(logLevel))
+	}
+
 	if logging.LogLevel() != "" {
-		options = append(options, nr.ConfigLogLevel(logging.LogLevel()))
 		t = logging.NewTransport("newrelic", t)
 	}
 
@@ -105,6 +121,104 @@ func (c *Config) Client() (*nr.NewRelic, error) {
 	return client, nil
 }
 
+// clientPoolSize is the number of clients the pool keeps. Beyond it, the
+// least recently used client is evicted.
+const clientPoolSize = 16
+
+// clientPool holds the clients built by sharedClient. It lives for the
+// provider process, like the provider configurations using its clients.
+var clientPool = struct {
+	sync.Mutex
+	clients map[string]*clientPoolEntry
+}{}
+
+type clientPoolEntry struct {
+	client   *nr.NewRelic
+	lastUsed time.Time
+}
+
+// clientPoolKey hashes the settings a client is built from, so the pool
+// doesn't hold API keys in the clear. It hashes the contents of the CA
+// certificate file, so a certificate replaced in place gets a new client.
+func (c *Config) clientPoolKey() (string, error) {
+	caCert, _, err := read(c.CACertFile)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, v := range []string{
+		c.AdminAPIKey,
+		c.PersonalAPIKey,
+		c.Region,
+		c.APIURL,
+		c.SyntheticsAPIURL,
+		c.InfrastructureAPIURL,
+		c.NerdGraphAPIURL,
+		c.CACertFile,
+		caCert,
+		strconv.FormatBool(c.InsecureSkipVerify),
+		c.LogLevel,
+		c.userAgent,
+	} {
+		_, _ = fmt.Fprintf(h, "%d:%s;", len(v), v)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// sharedClient returns a client for the configuration, reusing the one built
+// for an identical configuration, e.g. by another provider alias with the same
+// region and credentials. Aliases differing only in account_id share a
+// client, since the account is passed with each request. Sharing a client
+// shares its connections.
+//
+// The pool keeps at most clientPoolSize clients. An evicted client stays
+// usable by the configurations already holding it, and is released with them.
+func (c *Config) sharedClient() (*nr.NewRelic, error) {
+	key, err := c.clientPoolKey()
+	if err != nil {
+		return nil, err
+	}
+
+	clientPool.Lock()
+	defer clientPool.Unlock()
+
+	if e, ok := clientPool.clients[key]; ok {
+		log.Printf("[INFO] Reusing New Relic client")
+		e.lastUsed = time.Now()
+		return e.client, nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	if clientPool.clients == nil {
+		clientPool.clients = map[string]*clientPoolEntry{}
+	}
+	if len(clientPool.clients) >= clientPoolSize {
+		evictClientPoolEntry()
+	}
+	clientPool.clients[key] = &clientPoolEntry{client: client, lastUsed: time.Now()}
+
+	return client, nil
+}
+
+// evictClientPoolEntry drops the least recently used client from the pool.
+// The caller must hold the pool's lock.
+func evictClientPoolEntry() {
+	var oldest string
+	for key, e := range clientPool.clients {
+		if oldest == "" || e.lastUsed.Before(clientPool.clients[oldest].lastUsed) {
+			oldest = key
+		}
+	}
+
+	delete(clientPool.clients, oldest)
+}
+
 // ClientInsightsInsert returns a new Insights insert client
 func (c *Config) ClientInsightsInsert() (*insights.InsertClient, error) {
 	client := insights.NewInsertClient(c.InsightsInsertKey, c.InsightsAccountID)
@@ -133,10 +247,99 @@ func (c *Config) ClientInsightsInsert() (*insights.InsertClient, error) {
 
 // ProviderConfig for the custom provider
 type ProviderConfig struct {
-	NewClient            *nr.NewRelic
-	InsightsInsertClient *insights.InsertClient
-	AccountID            int
-	PersonalAPIKey       string
+	NewClient                      *nr.NewRelic
+	InsightsInsertClient           *insights.InsertClient
+	AccountID                      int
+	PersonalAPIKey                 string
+	DefaultSyntheticsLocations     []string
+	DefaultSyntheticsFrequency     int
+	DefaultSyntheticsStatus        string
+	DefaultSyntheticsSLAThreshold  float64
+	DefaultTags                    map[string]string
+	Workspace                      string
+	MonitorNamePrefix              string
+	RecreateOnUpdateError          bool
+	ValidateLocationsOffline       bool
+	BatchSyntheticsMonitorReads    bool
+	StrictSyntheticsMonitorOptions bool
+	ValidateSecureCredentialRefs   bool
+	CheckPrivateLocationCapacity   bool
+	PlanVerifyLive                 bool
+	OperationTimeouts              map[string]time.Duration
+	SyntheticsLocationGroups       map[string][]string
+
+	clientConfig           Config
+	accountAPIKeys         map[int]string
+	apiKeyClients          map[string]*nr.NewRelic
+	apiKeyClientsMu        sync.Mutex
+	metadataCache          metadataCache
+	syntheticsMonitorCache syntheticsMonitorCache
+	monitorOperations      syntheticsMonitorOperationLog
+}
+
+// The operation types of the provider's operation_timeouts.
+const (
+	operationSearch = "search"
+	operationCreate = "create"
+	operationRead   = "read"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
+// operationContext derives the context for an API operation of the given
+// type, bounded by its timeout in the provider's operation_timeouts. The
+// context is returned as is when no timeout is configured for the type.
+//
+// The client doesn't stop a request in flight when its context is done, so
+// the timeout only cuts off the waits, retries and paging between requests.
+func (c *ProviderConfig) operationContext(ctx context.Context, operation string) (context.Context, context.CancelFunc) {
+	if timeout, ok := c.OperationTimeouts[operation]; ok {
+		return context.WithTimeout(ctx, timeout)
+	}
+
+	return ctx, func() {}
+}
+
+// clientForAccount returns the client to use for resources in the given
+// account. Accounts configured in `account_credentials` use their own API key;
+// all other accounts use NewClient.
+func (c *ProviderConfig) clientForAccount(accountID int) (*nr.NewRelic, error) {
+	apiKey, ok := c.accountAPIKeys[accountID]
+	if !ok {
+		return c.NewClient, nil
+	}
+
+	return c.clientForAPIKey(apiKey)
+}
+
+// clientForAPIKey returns a client authenticated with apiKey. Clients are
+// created on first use and cached, so each distinct key is only set up once.
+func (c *ProviderConfig) clientForAPIKey(apiKey string) (*nr.NewRelic, error) {
+	if apiKey == "" || apiKey == c.PersonalAPIKey {
+		return c.NewClient, nil
+	}
+
+	c.apiKeyClientsMu.Lock()
+	defer c.apiKeyClientsMu.Unlock()
+
+	if client, ok := c.apiKeyClients[apiKey]; ok {
+		return client, nil
+	}
+
+	cfg := c.clientConfig
+	cfg.PersonalAPIKey = apiKey
+
+	client, err := cfg.sharedClient()
+	if err != nil {
+		return nil, fmt.Errorf("error initializing client: %w", err)
+	}
+
+	if c.apiKeyClients == nil {
+		c.apiKeyClients = map[string]*nr.NewRelic{}
+	}
+	c.apiKeyClients[apiKey] = client
+
+	return client, nil
 }
 
 func (c *ProviderConfig) hasNerdGraphCredentials() bool {
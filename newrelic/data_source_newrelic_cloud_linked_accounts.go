@@ -0,0 +1,140 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/pkg/cloud"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+)
+
+var cloudLinkedAccountProviders = []string{"aws", "azure", "gcp"}
+
+func dataSourceNewRelicCloudLinkedAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicCloudLinkedAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the New Relic account the cloud accounts are linked to.",
+			},
+			"cloud_provider": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cloudLinkedAccountProviders, false),
+				Description:  "Only return accounts of this cloud provider: aws, azure or gcp. Defaults to all of them.",
+			},
+			"accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The linked cloud accounts, sorted by ID.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the linked account.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the linked account.",
+						},
+						"provider": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cloud provider of the linked account.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the account was linked, in RFC 3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicCloudLinkedAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*ProviderConfig)
+	client := cfg.NewClient
+	accountID := selectAccountID(cfg, d)
+
+	log.Printf("[INFO] Reading New Relic Cloud linked accounts")
+
+	providers := cloudLinkedAccountProviders
+	if p, ok := d.GetOk("cloud_provider"); ok {
+		providers = []string{p.(string)}
+	}
+
+	var accounts []cloud.CloudLinkedAccount
+
+	for _, provider := range providers {
+		result, err := client.Cloud.GetLinkedAccountsWithContext(ctx, provider)
+		if err != nil {
+			// The client reports a provider without linked accounts as not found.
+			if _, ok := err.(*errors.NotFound); ok {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+
+		for _, a := range *result {
+			if a.NrAccountId == accountID {
+				accounts = append(accounts, a)
+			}
+		}
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].ID < accounts[j].ID
+	})
+
+	d.SetId(strconv.Itoa(accountID) + ":" + strings.Join(providers, ","))
+	_ = d.Set("account_id", accountID)
+
+	return diag.FromErr(d.Set("accounts", flattenCloudLinkedAccounts(accounts)))
+}
+
+func flattenCloudLinkedAccounts(accounts []cloud.CloudLinkedAccount) []interface{} {
+	out := make([]interface{}, len(accounts))
+
+	for i, a := range accounts {
+		out[i] = map[string]interface{}{
+			"id":         a.ID,
+			"name":       a.Name,
+			"provider":   cloudProviderSlug(a.Provider),
+			"created_at": time.Time(a.CreatedAt).UTC().Format(time.RFC3339),
+		}
+	}
+
+	return out
+}
+
+func cloudProviderSlug(provider cloud.CloudProviderInterface) string {
+	switch p := provider.(type) {
+	case *cloud.CloudAwsGovCloudProvider:
+		return p.Slug
+	case *cloud.CloudAwsProvider:
+		return p.Slug
+	case *cloud.CloudBaseProvider:
+		return p.Slug
+	case *cloud.CloudGcpProvider:
+		return p.Slug
+	case *cloud.CloudProvider:
+		return p.Slug
+	}
+
+	return ""
+}
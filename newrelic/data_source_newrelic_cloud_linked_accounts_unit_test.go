@@ -0,0 +1,46 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceNewRelicCloudLinkedAccountsRead(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		switch req.Variables["provider"] {
+		case "aws":
+			_, _ = w.Write([]byte(`{"data":{"actor":{"cloud":{"linkedAccounts":[
+				{"id":20,"name":"prod","nrAccountId":123,"createdAt":1600000000,"provider":{"__typename":"CloudAwsProvider","slug":"aws"}},
+				{"id":30,"name":"other","nrAccountId":456,"createdAt":1600000000,"provider":{"__typename":"CloudAwsProvider","slug":"aws"}}
+			]}}}}`))
+		case "gcp":
+			_, _ = w.Write([]byte(`{"data":{"actor":{"cloud":{"linkedAccounts":[
+				{"id":10,"name":"project","nrAccountId":123,"createdAt":1600000000,"provider":{"__typename":"CloudGcpProvider","slug":"gcp"}}
+			]}}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{"actor":{"cloud":{"linkedAccounts":[]}}}}`))
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicCloudLinkedAccounts().Schema, map[string]interface{}{})
+
+	diags := dataSourceNewRelicCloudLinkedAccountsRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"id": 10, "name": "project", "provider": "gcp", "created_at": "2020-09-13T12:26:40Z"},
+		map[string]interface{}{"id": 20, "name": "prod", "provider": "aws", "created_at": "2020-09-13T12:26:40Z"},
+	}, d.Get("accounts"))
+}
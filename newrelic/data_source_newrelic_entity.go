@@ -92,7 +92,11 @@ func dataSourceNewRelicEntity() *schema.Resource {
 }
 
 func dataSourceNewRelicEntityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ProviderConfig).NewClient
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+
+	ctx, cancel := providerConfig.operationContext(ctx, operationSearch)
+	defer cancel()
 
 	log.Printf("[INFO] Reading New Relic entities")
 
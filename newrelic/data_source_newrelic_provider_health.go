@@ -0,0 +1,83 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+)
+
+const providerHealthQuery = `query($accountId: Int!) { actor {
+	user {
+		email
+	}
+	account(id: $accountId) {
+		id
+	}
+} }`
+
+type providerHealthResponse struct {
+	Actor struct {
+		User struct {
+			Email string `json:"email"`
+		} `json:"user"`
+		Account struct {
+			ID int `json:"id"`
+		} `json:"account"`
+	} `json:"actor"`
+}
+
+func dataSourceNewRelicProviderHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicProviderHealthRead,
+		Schema: map[string]*schema.Schema{
+			"authenticated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the provider's API key was accepted.",
+			},
+			"account_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The provider's account ID, confirmed accessible with the API key.",
+			},
+			"user_email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email of the user the API key belongs to.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicProviderHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+
+	log.Printf("[INFO] Checking New Relic provider credentials")
+
+	d.SetId(strconv.Itoa(providerConfig.AccountID))
+
+	resp := providerHealthResponse{}
+	vars := map[string]interface{}{
+		"accountId": providerConfig.AccountID,
+	}
+
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, providerHealthQuery, vars, &resp); err != nil {
+		if _, ok := err.(*errors.UnauthorizedError); ok {
+			_ = d.Set("authenticated", false)
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("authenticated", true)
+	_ = d.Set("account_id", resp.Actor.Account.ID)
+	_ = d.Set("user_email", resp.Actor.User.Email)
+
+	return nil
+}
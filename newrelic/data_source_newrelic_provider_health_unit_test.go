@@ -0,0 +1,40 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceNewRelicProviderHealthRead(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"actor":{"user":{"email":"user@example.com"},"account":{"id":123}}}}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicProviderHealth().Schema, map[string]interface{}{})
+
+	diags := dataSourceNewRelicProviderHealthRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, true, d.Get("authenticated"))
+	require.Equal(t, 123, d.Get("account_id"))
+	require.Equal(t, "user@example.com", d.Get("user_email"))
+}
+
+func TestDataSourceNewRelicProviderHealthRead_Unauthorized(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Invalid API key","extensions":{"error_code":"BAD_API_KEY"}}]}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicProviderHealth().Schema, map[string]interface{}{})
+
+	diags := dataSourceNewRelicProviderHealthRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, false, d.Get("authenticated"))
+}
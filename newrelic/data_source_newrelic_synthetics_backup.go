@@ -0,0 +1,193 @@
+package newrelic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+type syntheticsBackup struct {
+	Monitors []syntheticsBackupMonitor `json:"monitors"`
+}
+
+// syntheticsBackupMonitor is a monitor's export along with its ID and the
+// tags set on its entity, leaving out system tags.
+type syntheticsBackupMonitor struct {
+	ID string `json:"id"`
+	*syntheticsMonitorExport
+	Tags map[string][]string `json:"tags,omitempty"`
+}
+
+func dataSourceNewRelicSyntheticsBackup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsBackupRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The New Relic account ID of the monitors.",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 16),
+				Description:  "How many monitor scripts to fetch at once.",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Every monitor's configuration, script and tags, as normalized JSON sorted by monitor ID.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Backing up New Relic Synthetics monitors")
+
+	// ListMonitorsWithContext follows the API's pages.
+	monitors, err := client.Synthetics.ListMonitorsWithContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(monitors, func(i, j int) bool {
+		return monitors[i].ID < monitors[j].ID
+	})
+
+	scripts, err := getSyntheticsBackupScripts(ctx, client, monitors, d.Get("concurrency").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	searchCtx, cancel := providerConfig.operationContext(ctx, operationSearch)
+	tags, err := getSyntheticsBackupTags(searchCtx, client, accountID)
+	cancel()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backup := syntheticsBackup{Monitors: make([]syntheticsBackupMonitor, len(monitors))}
+	for i, m := range monitors {
+		backup.Monitors[i] = syntheticsBackupMonitor{
+			ID:                      m.ID,
+			syntheticsMonitorExport: buildSyntheticsMonitorExport(m, scripts[i]),
+			Tags:                    tags[string(syntheticsMonitorGUID(accountID, m.ID))],
+		}
+	}
+
+	out, err := json.Marshal(backup)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sum := sha256.Sum256(out)
+	d.SetId(hex.EncodeToString(sum[:]))
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("json", string(out))
+
+	return nil
+}
+
+// getSyntheticsBackupScripts fetches the monitors' scripts with at most
+// concurrency requests in flight, returning them in the monitors' order. The
+// first error cancels the remaining fetches.
+func getSyntheticsBackupScripts(ctx context.Context, client *nr.NewRelic, monitors []*synthetics.Monitor, concurrency int) ([]string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	scripts := make([]string, len(monitors))
+	sem := make(chan struct{}, concurrency)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	for i, m := range monitors {
+		if !isScriptedSyntheticsMonitorType(m.Type) {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, m *synthetics.Monitor) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			script, err := getSyntheticsMonitorExportScript(ctx, client, m)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("error fetching the script of synthetics monitor %s: %w", m.ID, err)
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+
+			scripts[i] = script
+		}(i, m)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return scripts, nil
+}
+
+// getSyntheticsBackupTags returns the tags of the account's monitor entities
+// by GUID, read with a single paged entity search.
+func getSyntheticsBackupTags(ctx context.Context, client *nr.NewRelic, accountID int) (map[string]map[string][]string, error) {
+	entities, err := searchEntitiesWithTags(ctx, client, syntheticsMonitorTagQuery(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := map[string]map[string][]string{}
+	for _, e := range entities {
+		if e.AccountID != accountID {
+			continue
+		}
+
+		for _, t := range e.Tags {
+			if stringInSlice(defaultTags, t.Key) {
+				continue
+			}
+
+			if tags[string(e.GUID)] == nil {
+				tags[string(e.GUID)] = map[string][]string{}
+			}
+			tags[string(e.GUID)][t.Key] = t.Values
+		}
+	}
+
+	return tags, nil
+}
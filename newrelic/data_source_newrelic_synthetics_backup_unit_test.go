@@ -0,0 +1,60 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceNewRelicSyntheticsBackupRead(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v4/monitors":
+			_, _ = w.Write([]byte(`{"monitors":[
+				{"id":"def-456","name":"bar","type":"SCRIPT_API","frequency":5,"locations":["AWS_US_EAST_1"],"status":"ENABLED"},
+				{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":5,"uri":"https://example.com","locations":["AWS_US_EAST_1"],"status":"ENABLED"}
+			],"count":2}`))
+		case "/v4/monitors/def-456/script":
+			_, _ = w.Write([]byte(`{"scriptText":"` + base64.StdEncoding.EncodeToString([]byte("console.log('ok');")) + `"}`))
+		default:
+			_, _ = fmt.Fprintf(w, `{"data":{"actor":{"entitySearch":{"results":{"entities":[
+				{"accountId":123,"guid":%q,"tags":[{"key":"team","values":["synthetics"]},{"key":"accountId","values":["123"]}]}
+			]}}}}}`, syntheticsMonitorGUID(123, "abc-123"))
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicSyntheticsBackup().Schema, map[string]interface{}{})
+
+	diags := dataSourceNewRelicSyntheticsBackupRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.JSONEq(t, `{"monitors":[
+		{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":5,"uri":"https://example.com","locations":["AWS_US_EAST_1"],"status":"ENABLED","sla_threshold":0,
+		 "options":{"verify_ssl":false,"bypass_head_request":false,"treat_redirect_as_failure":false},"tags":{"team":["synthetics"]}},
+		{"id":"def-456","name":"bar","type":"SCRIPT_API","frequency":5,"locations":["AWS_US_EAST_1"],"status":"ENABLED","sla_threshold":0,
+		 "options":{"verify_ssl":false,"bypass_head_request":false,"treat_redirect_as_failure":false},"script":"console.log('ok');"}
+	]}`, d.Get("json").(string))
+}
+
+func TestGetSyntheticsBackupScripts_Error(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	monitors := []*synthetics.Monitor{
+		{ID: "abc-123", Type: synthetics.MonitorTypes.Ping},
+		{ID: "def-456", Type: synthetics.MonitorTypes.APITest},
+	}
+
+	_, err := getSyntheticsBackupScripts(context.Background(), providerConfig.NewClient, monitors, 2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "error fetching the script of synthetics monitor def-456")
+}
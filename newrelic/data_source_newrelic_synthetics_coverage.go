@@ -0,0 +1,147 @@
+package newrelic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNewRelicSyntheticsCoverage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsCoverageRead,
+		Schema: map[string]*schema.Schema{
+			"monitor_guids": {
+				Type:         schema.TypeSet,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				ExactlyOneOf: []string{"monitor_guids", "tag"},
+				Description:  "The entity GUIDs of the monitors to report on.",
+			},
+			"tag": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"monitor_guids", "tag"},
+				Description:  "Report on the monitors having all of these tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The tag key.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The tag value.",
+						},
+					},
+				},
+			},
+			"covered": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The GUIDs of the monitors referenced by at least one synthetics alert condition.",
+			},
+			"uncovered": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The GUIDs of the monitors not referenced by any synthetics alert condition.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsCoverageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+
+	log.Printf("[INFO] Reading New Relic Synthetics alert coverage")
+
+	var guids []string
+	if v, ok := d.GetOk("monitor_guids"); ok {
+		for _, g := range v.(*schema.Set).List() {
+			guids = append(guids, g.(string))
+		}
+	} else {
+		searchCtx, cancel := providerConfig.operationContext(ctx, operationSearch)
+		entities, err := searchEntitiesWithTags(searchCtx, client, syntheticsMonitorTagQuery(d.Get("tag").([]interface{})))
+		cancel()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, e := range entities {
+			guids = append(guids, string(e.GUID))
+		}
+	}
+
+	sort.Strings(guids)
+
+	byMonitor, err := listSyntheticsAlertConditionIDsByMonitor(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	covered := []string{}
+	uncovered := []string{}
+
+	for _, guid := range guids {
+		monitorID, err := syntheticsMonitorIDFromGUID(guid)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if len(byMonitor[monitorID]) > 0 {
+			covered = append(covered, guid)
+		} else {
+			uncovered = append(uncovered, guid)
+		}
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(guids, ",")))
+	d.SetId(hex.EncodeToString(sum[:]))
+
+	_ = d.Set("covered", covered)
+	_ = d.Set("uncovered", uncovered)
+
+	return nil
+}
+
+// syntheticsMonitorTagQuery builds the entity search query for the monitors
+// having all of the given tags.
+func syntheticsMonitorTagQuery(tags []interface{}) string {
+	clauses := []string{"domain = 'SYNTH'", "type = 'MONITOR'"}
+
+	for _, t := range tags {
+		tag := t.(map[string]interface{})
+		clauses = append(clauses, fmt.Sprintf("tags.`%s` = '%s'", escapeNRQLIdentifier(tag["key"].(string)), escapeNRQLString(tag["value"].(string))))
+	}
+
+	return strings.Join(clauses, " AND ")
+}
+
+// syntheticsMonitorIDFromGUID returns the monitor ID encoded in a monitor's
+// entity GUID. See syntheticsMonitorGUID.
+func syntheticsMonitorIDFromGUID(guid string) (string, error) {
+	raw, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(guid, "="))
+	if err != nil {
+		return "", fmt.Errorf("invalid synthetics monitor GUID %q: %w", guid, err)
+	}
+
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 4 || parts[1] != "SYNTH" || parts[2] != "MONITOR" {
+		return "", fmt.Errorf("invalid synthetics monitor GUID %q: not a monitor", guid)
+	}
+
+	return parts[3], nil
+}
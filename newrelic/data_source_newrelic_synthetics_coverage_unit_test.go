@@ -0,0 +1,57 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsMonitorIDFromGUID(t *testing.T) {
+	id, err := syntheticsMonitorIDFromGUID(string(syntheticsMonitorGUID(123, "abc-123")))
+	require.NoError(t, err)
+	require.Equal(t, "abc-123", id)
+
+	_, err = syntheticsMonitorIDFromGUID("MTIzfEFQTXxBUFBMSUNBVElPTnw0NTY")
+	require.Error(t, err)
+}
+
+func TestSyntheticsMonitorTagQuery(t *testing.T) {
+	query := syntheticsMonitorTagQuery([]interface{}{
+		map[string]interface{}{"key": "team", "value": "o'neil"},
+		map[string]interface{}{"key": "a`b", "value": `c:\`},
+	})
+
+	require.Equal(t, "domain = 'SYNTH' AND type = 'MONITOR' AND tags.`team` = 'o\\'neil' AND tags.`a\\`b` = 'c:\\\\'", query)
+}
+
+func TestDataSourceNewRelicSyntheticsCoverageRead(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/alerts_policies.json":
+			_, _ = w.Write([]byte(`{"policies":[{"id":1,"name":"foo"}]}`))
+		case "/alerts_synthetics_conditions.json":
+			_, _ = w.Write([]byte(`{"synthetics_conditions":[{"id":10,"monitor_id":"abc-123"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"location_failure_conditions":[{"id":20,"entities":["def-456"]}]}`))
+		}
+	})
+
+	covered1 := string(syntheticsMonitorGUID(123, "abc-123"))
+	covered2 := string(syntheticsMonitorGUID(123, "def-456"))
+	uncovered := string(syntheticsMonitorGUID(123, "ghi-789"))
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicSyntheticsCoverage().Schema, map[string]interface{}{
+		"monitor_guids": []interface{}{covered1, covered2, uncovered},
+	})
+
+	diags := dataSourceNewRelicSyntheticsCoverageRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.ElementsMatch(t, []interface{}{covered1, covered2}, d.Get("covered"))
+	require.Equal(t, []interface{}{uncovered}, d.Get("uncovered"))
+}
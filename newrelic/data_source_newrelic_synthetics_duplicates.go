@@ -0,0 +1,139 @@
+package newrelic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+func dataSourceNewRelicSyntheticsDuplicates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsDuplicatesRead,
+		Schema: map[string]*schema.Schema{
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The groups of monitors checking the same URI with the same type from the same locations.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URI checked by the monitors.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the monitors.",
+						},
+						"locations": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+							Description: "The locations of the monitors, sorted.",
+						},
+						"monitor_ids": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+							Description: "The IDs of the duplicate monitors, sorted.",
+						},
+						"names": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+							Description: "The names of the duplicate monitors, in the order of monitor_ids.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsDuplicatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Reading duplicate New Relic Synthetics monitors")
+
+	monitors, err := client.Synthetics.ListMonitorsWithContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groups := groupSyntheticsDuplicateMonitors(monitors)
+
+	ids := []string{}
+	for _, g := range groups {
+		ids = append(ids, strings.Join(g.(map[string]interface{})["monitor_ids"].([]string), ","))
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(ids, ";")))
+	d.SetId(hex.EncodeToString(sum[:]))
+
+	return diag.FromErr(d.Set("groups", groups))
+}
+
+// groupSyntheticsDuplicateMonitors groups the monitors with the same type,
+// URI and locations, and returns the groups having more than one monitor,
+// sorted by type and URI. URIs are compared in their normalized form. Scripted
+// monitors have no URI and are left out, since what they check is in their
+// scripts.
+func groupSyntheticsDuplicateMonitors(monitors []*synthetics.Monitor) []interface{} {
+	byKey := map[string][]*synthetics.Monitor{}
+
+	for _, m := range monitors {
+		if m.URI == "" || isScriptedSyntheticsMonitorType(m.Type) {
+			continue
+		}
+
+		locations := append([]string{}, m.Locations...)
+		sort.Strings(locations)
+
+		key := strings.Join([]string{string(m.Type), normalizeSyntheticsMonitorURI(m.URI), strings.Join(locations, ",")}, "\n")
+		byKey[key] = append(byKey[key], m)
+	}
+
+	keys := []string{}
+	for k, ms := range byKey {
+		if len(ms) > 1 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	groups := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		ms := byKey[k]
+		sort.Slice(ms, func(i, j int) bool {
+			return ms[i].ID < ms[j].ID
+		})
+
+		locations := append([]string{}, ms[0].Locations...)
+		sort.Strings(locations)
+
+		monitorIDs := make([]string, len(ms))
+		names := make([]string, len(ms))
+		for i, m := range ms {
+			monitorIDs[i] = m.ID
+			names[i] = m.Name
+		}
+
+		groups = append(groups, map[string]interface{}{
+			"uri":         normalizeSyntheticsMonitorURI(ms[0].URI),
+			"type":        string(ms[0].Type),
+			"locations":   locations,
+			"monitor_ids": monitorIDs,
+			"names":       names,
+		})
+	}
+
+	return groups
+}
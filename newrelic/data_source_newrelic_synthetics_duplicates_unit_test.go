@@ -0,0 +1,41 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceNewRelicSyntheticsDuplicatesRead(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"monitors":[
+			{"id":"ccc-333","name":"c","type":"SIMPLE","frequency":5,"uri":"https://Example.com/","locations":["AWS_US_WEST_1","AWS_US_EAST_1"]},
+			{"id":"aaa-111","name":"a","type":"SIMPLE","frequency":5,"uri":"https://example.com","locations":["AWS_US_EAST_1","AWS_US_WEST_1"]},
+			{"id":"bbb-222","name":"b","type":"BROWSER","frequency":5,"uri":"https://example.com","locations":["AWS_US_EAST_1","AWS_US_WEST_1"]},
+			{"id":"ddd-444","name":"d","type":"SIMPLE","frequency":5,"uri":"https://example.com","locations":["AWS_US_EAST_1"]},
+			{"id":"eee-555","name":"e","type":"SCRIPT_API","frequency":5,"locations":["AWS_US_EAST_1"]},
+			{"id":"fff-666","name":"f","type":"SCRIPT_API","frequency":5,"locations":["AWS_US_EAST_1"]}
+		],"count":6}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicSyntheticsDuplicates().Schema, map[string]interface{}{})
+
+	diags := dataSourceNewRelicSyntheticsDuplicatesRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.NotEmpty(t, d.Id())
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"uri":         "https://example.com",
+			"type":        "SIMPLE",
+			"locations":   []interface{}{"AWS_US_EAST_1", "AWS_US_WEST_1"},
+			"monitor_ids": []interface{}{"aaa-111", "ccc-333"},
+			"names":       []interface{}{"a", "c"},
+		},
+	}, d.Get("groups"))
+}
@@ -0,0 +1,64 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceNewRelicSyntheticsLocationGroup exposes a synthetics_location_group
+// of the provider configuration. It makes no API calls.
+func dataSourceNewRelicSyntheticsLocationGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsLocationGroupRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the location group.",
+			},
+			"locations": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The sorted locations of the group.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsLocationGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	locations, err := syntheticsLocationGroupLocations(meta.(*ProviderConfig), name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(name)
+	_ = d.Set("locations", locations)
+
+	return nil
+}
+
+// syntheticsLocationGroupLocations returns the locations of the provider's
+// synthetics_location_group with the given name.
+func syntheticsLocationGroupLocations(providerConfig *ProviderConfig, name string) ([]string, error) {
+	var locations []string
+	ok := false
+	if providerConfig != nil {
+		locations, ok = providerConfig.SyntheticsLocationGroups[name]
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("synthetics location group %q is not defined in the provider configuration", name)
+	}
+
+	if len(locations) == 0 {
+		return nil, fmt.Errorf("synthetics location group %q has no locations", name)
+	}
+
+	return locations, nil
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/common"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
 	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
 )
 
@@ -15,15 +18,55 @@ func dataSourceNewRelicSyntheticsMonitor() *schema.Resource {
 		ReadContext: dataSourceNewRelicSyntheticsMonitorRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the synthetics monitor in New Relic.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "guid"},
+				Description:  "The name of the synthetics monitor in New Relic.",
+			},
+			"guid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "guid"},
+				Description:  "The entity GUID of the synthetics monitor in New Relic.",
 			},
 			"monitor_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The ID of the synthetics monitor.",
 			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The monitor type.",
+			},
+			"frequency": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The interval (in minutes) at which this monitor runs.",
+			},
+			"uri": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URI the monitor hits.",
+			},
+			"locations": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The locations in which this monitor runs.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The monitor status.",
+			},
+			"sla_threshold": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The base threshold (in seconds) used to calculate the apdex score for the SLA report.",
+			},
 		},
 	}
 }
@@ -33,27 +76,88 @@ func dataSourceNewRelicSyntheticsMonitorRead(ctx context.Context, d *schema.Reso
 
 	log.Printf("[INFO] Reading New Relic synthetics monitors")
 
-	name := d.Get("name").(string)
-	monitors, err := client.Synthetics.ListMonitorsWithContext(ctx)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	var monitor *synthetics.Monitor
-	for _, m := range monitors {
-		if m.Name == name {
-			monitor = m
-			break
+	var err error
+
+	guid, lookupByGUID := d.GetOk("guid")
+	if lookupByGUID {
+		monitor, err = getSyntheticsMonitorByGUID(ctx, meta.(*ProviderConfig), common.EntityGUID(guid.(string)))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		name := d.Get("name").(string)
+		monitors, err := client.Synthetics.ListMonitorsWithContext(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, m := range monitors {
+			if m.Name == name {
+				monitor = m
+				break
+			}
 		}
-	}
 
-	if monitor == nil {
-		return diag.FromErr(fmt.Errorf("the name '%s' does not match any New Relic monitors", name))
+		if monitor == nil {
+			return diag.FromErr(fmt.Errorf("the name '%s' does not match any New Relic monitors", name))
+		}
 	}
 
 	d.SetId(monitor.ID)
 	_ = d.Set("name", monitor.Name)
 	_ = d.Set("monitor_id", monitor.ID)
+	if !lookupByGUID {
+		// Monitors found by name are listed from the provider's account.
+		_ = d.Set("guid", string(syntheticsMonitorGUID(meta.(*ProviderConfig).AccountID, monitor.ID)))
+	}
+	_ = d.Set("type", monitor.Type)
+	_ = d.Set("frequency", monitor.Frequency)
+	_ = d.Set("uri", monitor.URI)
+	_ = d.Set("locations", monitor.Locations)
+	_ = d.Set("status", monitor.Status)
+	_ = d.Set("sla_threshold", monitor.SLAThreshold)
 
 	return nil
 }
+
+// getSyntheticsMonitorByGUID resolves a monitor entity GUID to its monitor ID
+// through an entity lookup and fetches the monitor.
+func getSyntheticsMonitorByGUID(ctx context.Context, providerConfig *ProviderConfig, guid common.EntityGUID) (*synthetics.Monitor, error) {
+	monitorID, err := resolveSyntheticsMonitorID(ctx, providerConfig, providerConfig.NewClient, guid)
+	if err != nil {
+		return nil, err
+	}
+
+	return providerConfig.NewClient.Synthetics.GetMonitorWithContext(ctx, monitorID)
+}
+
+// resolveSyntheticsMonitorID looks up the monitor ID of a monitor entity GUID
+// through the provider's shared metadata cache.
+func resolveSyntheticsMonitorID(ctx context.Context, providerConfig *ProviderConfig, client *nr.NewRelic, guid common.EntityGUID) (string, error) {
+	monitorID, err := providerConfig.metadataCache.get("synthetics-monitor-id:"+string(guid), func() (interface{}, error) {
+		ctx, cancel := providerConfig.metadataFetchContext(ctx)
+		defer cancel()
+
+		entity, err := client.Entities.GetEntityWithContext(ctx, guid)
+		if err != nil {
+			return nil, err
+		}
+
+		if entity == nil || *entity == nil {
+			return nil, fmt.Errorf("the guid '%s' does not match any New Relic entities", guid)
+		}
+
+		monitorEntity, ok := (*entity).(*entities.SyntheticMonitorEntity)
+		if !ok {
+			return nil, fmt.Errorf("the guid '%s' does not belong to a synthetics monitor", guid)
+		}
+
+		return monitorEntity.MonitorId, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return monitorID.(string), nil
+}
@@ -0,0 +1,139 @@
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+// syntheticsMonitorExport is the normalized representation of a monitor used
+// for exports. Fields are listed explicitly (rather than marshalling the
+// client struct) so the output doesn't change when the client adds fields
+// or when the API returns values that aren't part of the monitor's
+// configuration, such as timestamps.
+type syntheticsMonitorExport struct {
+	Name         string                         `json:"name"`
+	Type         string                         `json:"type"`
+	Frequency    int                            `json:"frequency"`
+	URI          string                         `json:"uri,omitempty"`
+	Locations    []string                       `json:"locations"`
+	Status       string                         `json:"status"`
+	SLAThreshold float64                        `json:"sla_threshold"`
+	Options      syntheticsMonitorExportOptions `json:"options"`
+	Script       string                         `json:"script,omitempty"`
+}
+
+type syntheticsMonitorExportOptions struct {
+	ValidationString       string `json:"validation_string,omitempty"`
+	VerifySSL              bool   `json:"verify_ssl"`
+	BypassHEADRequest      bool   `json:"bypass_head_request"`
+	TreatRedirectAsFailure bool   `json:"treat_redirect_as_failure"`
+}
+
+func dataSourceNewRelicSyntheticsMonitorExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsMonitorExportRead,
+		Schema: map[string]*schema.Schema{
+			"monitor_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the synthetics monitor to export.",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The monitor's configuration, including its script for scripted monitors, as normalized JSON.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsMonitorExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+	monitorID := d.Get("monitor_id").(string)
+
+	log.Printf("[INFO] Exporting New Relic Synthetics monitor %s", monitorID)
+
+	export, err := getSyntheticsMonitorExport(ctx, client, monitorID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := json.Marshal(export)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(monitorID)
+	_ = d.Set("json", string(out))
+
+	return nil
+}
+
+// getSyntheticsMonitorExport fetches a monitor, and its script when the
+// monitor is scripted, and normalizes them for export.
+func getSyntheticsMonitorExport(ctx context.Context, client *nr.NewRelic, monitorID string) (*syntheticsMonitorExport, error) {
+	monitor, err := client.Synthetics.GetMonitorWithContext(ctx, monitorID)
+	if err != nil {
+		return nil, err
+	}
+
+	script, err := getSyntheticsMonitorExportScript(ctx, client, monitor)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildSyntheticsMonitorExport(monitor, script), nil
+}
+
+// getSyntheticsMonitorExportScript fetches the script of a scripted monitor.
+// Other monitors, and scripted monitors without an uploaded script, export an
+// empty script.
+func getSyntheticsMonitorExportScript(ctx context.Context, client *nr.NewRelic, monitor *synthetics.Monitor) (string, error) {
+	if !isScriptedSyntheticsMonitorType(monitor.Type) {
+		return "", nil
+	}
+
+	s, err := client.Synthetics.GetMonitorScriptWithContext(ctx, monitor.ID)
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return s.Text, nil
+}
+
+func buildSyntheticsMonitorExport(monitor *synthetics.Monitor, script string) *syntheticsMonitorExport {
+	locations := append([]string{}, monitor.Locations...)
+	sort.Strings(locations)
+
+	return &syntheticsMonitorExport{
+		Name:         monitor.Name,
+		Type:         string(monitor.Type),
+		Frequency:    int(monitor.Frequency),
+		URI:          monitor.URI,
+		Locations:    locations,
+		Status:       string(monitor.Status),
+		SLAThreshold: monitor.SLAThreshold,
+		Options: syntheticsMonitorExportOptions{
+			ValidationString:       monitor.Options.ValidationString,
+			VerifySSL:              monitor.Options.VerifySSL,
+			BypassHEADRequest:      monitor.Options.BypassHEADRequest,
+			TreatRedirectAsFailure: monitor.Options.TreatRedirectAsFailure,
+		},
+		Script: script,
+	}
+}
+
+func isScriptedSyntheticsMonitorType(monitorType synthetics.MonitorType) bool {
+	return monitorType == synthetics.MonitorTypes.ScriptedBrowser || monitorType == synthetics.MonitorTypes.APITest
+}
@@ -0,0 +1,45 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSyntheticsMonitorExport(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v4/monitors/abc-123":
+			_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SCRIPT_API","frequency":5,"locations":["AWS_US_WEST_1","AWS_US_EAST_1"],"status":"ENABLED","slaThreshold":7,"modifiedAt":"2021-01-01T00:00:00.000+0000"}`))
+		case "/v4/monitors/abc-123/script":
+			_, _ = w.Write([]byte(`{"scriptText":"` + base64.StdEncoding.EncodeToString([]byte("console.log('ok');")) + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	export, err := getSyntheticsMonitorExport(context.Background(), providerConfig.NewClient, "abc-123")
+	require.NoError(t, err)
+	require.Equal(t, []string{"AWS_US_EAST_1", "AWS_US_WEST_1"}, export.Locations)
+	require.Equal(t, "console.log('ok');", export.Script)
+}
+
+func TestGetSyntheticsMonitorExport_NoScript(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v4/monitors/abc-123" {
+			_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SCRIPT_BROWSER","frequency":5,"locations":["AWS_US_EAST_1"],"status":"ENABLED"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	export, err := getSyntheticsMonitorExport(context.Background(), providerConfig.NewClient, "abc-123")
+	require.NoError(t, err)
+	require.Empty(t, export.Script)
+}
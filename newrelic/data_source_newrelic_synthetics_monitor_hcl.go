@@ -0,0 +1,180 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+var hclIdentifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+func dataSourceNewRelicSyntheticsMonitorHCL() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsMonitorHCLRead,
+		Schema: map[string]*schema.Schema{
+			"monitor_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the synthetics monitor.",
+			},
+			"resource_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the generated resource blocks. Defaults to the monitor name, lowercased, with each run of unsupported characters replaced by an underscore.",
+				ValidateFunc: validation.StringMatch(hclIdentifierRegexp, "must be a valid Terraform resource name"),
+			},
+			"hcl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The HCL configuration of the monitor, and of its script for scripted monitors.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsMonitorHCLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+	monitorID := d.Get("monitor_id").(string)
+
+	log.Printf("[INFO] Generating HCL for New Relic Synthetics monitor %s", monitorID)
+
+	export, err := getSyntheticsMonitorExport(ctx, client, monitorID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("resource_name").(string)
+	if name == "" {
+		name = syntheticsMonitorResourceName(export.Name)
+	}
+
+	d.SetId(monitorID)
+	_ = d.Set("hcl", syntheticsMonitorHCL(name, export))
+
+	return nil
+}
+
+// syntheticsMonitorResourceName derives a Terraform resource name from a
+// monitor name.
+func syntheticsMonitorResourceName(monitorName string) string {
+	var b strings.Builder
+	replaced := false
+	for _, r := range strings.ToLower(monitorName) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			b.WriteRune(r)
+			replaced = false
+		} else if !replaced {
+			b.WriteRune('_')
+			replaced = true
+		}
+	}
+
+	name := strings.Trim(b.String(), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "monitor_" + name
+	}
+
+	return name
+}
+
+// syntheticsMonitorHCL renders a newrelic_synthetics_monitor resource, plus a
+// newrelic_synthetics_monitor_script resource when the export has a script,
+// formatted the way `terraform fmt` would.
+func syntheticsMonitorHCL(name string, export *syntheticsMonitorExport) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "resource \"newrelic_synthetics_monitor\" %q {\n", name)
+
+	locations := make([]string, len(export.Locations))
+	for i, l := range export.Locations {
+		locations[i] = hclString(l)
+	}
+
+	writeHCLAttributes(&b, [][2]string{
+		{"name", hclString(export.Name)},
+		{"type", hclString(export.Type)},
+		{"frequency", strconv.Itoa(export.Frequency)},
+		{"status", hclString(export.Status)},
+		{"locations", "[" + strings.Join(locations, ", ") + "]"},
+		{"sla_threshold", strconv.FormatFloat(export.SLAThreshold, 'f', -1, 64)},
+	})
+
+	monitorType := synthetics.MonitorType(export.Type)
+	if monitorType == synthetics.MonitorTypes.Ping || monitorType == synthetics.MonitorTypes.Browser {
+		attrs := [][2]string{{"uri", hclString(export.URI)}}
+		if export.Options.ValidationString != "" {
+			attrs = append(attrs, [2]string{"validation_string", hclString(export.Options.ValidationString)})
+		}
+		if monitorType == synthetics.MonitorTypes.Ping {
+			attrs = append(attrs,
+				[2]string{"bypass_head_request", strconv.FormatBool(export.Options.BypassHEADRequest)},
+				[2]string{"treat_redirect_as_failure", strconv.FormatBool(export.Options.TreatRedirectAsFailure)},
+			)
+		}
+
+		b.WriteString("\n")
+		writeHCLAttributes(&b, attrs)
+
+		b.WriteString("\n  ssl {\n")
+		fmt.Fprintf(&b, "    verify_certificate = %t\n", export.Options.VerifySSL)
+		b.WriteString("  }\n")
+	}
+
+	b.WriteString("}\n")
+
+	if export.Script != "" {
+		fmt.Fprintf(&b, "\nresource \"newrelic_synthetics_monitor_script\" %q {\n", name)
+		fmt.Fprintf(&b, "  monitor_id = newrelic_synthetics_monitor.%s.id\n", name)
+		fmt.Fprintf(&b, "  text       = %s\n", hclHeredoc(export.Script))
+		b.WriteString("}\n")
+	}
+
+	return b.String()
+}
+
+// writeHCLAttributes writes the attributes with their equals signs aligned.
+func writeHCLAttributes(b *strings.Builder, attrs [][2]string) {
+	width := 0
+	for _, a := range attrs {
+		if len(a[0]) > width {
+			width = len(a[0])
+		}
+	}
+
+	for _, a := range attrs {
+		fmt.Fprintf(b, "  %-*s = %s\n", width, a[0], a[1])
+	}
+}
+
+// hclString quotes s as an HCL string literal, escaping template sequences.
+func hclString(s string) string {
+	return hclEscapeTemplate(strconv.Quote(s))
+}
+
+// hclHeredoc renders s as an HCL heredoc, picking a delimiter that doesn't
+// occur in the text. A heredoc always ends in a newline, so text without a
+// trailing newline is rendered as a quoted string to keep it unchanged.
+func hclHeredoc(s string) string {
+	if !strings.HasSuffix(s, "\n") {
+		return hclString(s)
+	}
+
+	delimiter := "EOT"
+	for i := 1; strings.Contains(s, delimiter); i++ {
+		delimiter = fmt.Sprintf("EOT%d", i)
+	}
+
+	return "<<" + delimiter + "\n" + hclEscapeTemplate(s) + delimiter
+}
+
+func hclEscapeTemplate(s string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(s)
+}
@@ -0,0 +1,91 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsMonitorResourceName(t *testing.T) {
+	require.Equal(t, "my_monitor_1", syntheticsMonitorResourceName("My Monitor #1"))
+	require.Equal(t, "api_check", syntheticsMonitorResourceName("[API] check"))
+	require.Equal(t, "monitor_123", syntheticsMonitorResourceName("123"))
+	require.Equal(t, "monitor_", syntheticsMonitorResourceName("!!"))
+}
+
+func TestSyntheticsMonitorHCL_Simple(t *testing.T) {
+	export := &syntheticsMonitorExport{
+		Name:         "foo",
+		Type:         "SIMPLE",
+		Frequency:    5,
+		URI:          "https://example.com",
+		Locations:    []string{"AWS_US_EAST_1", "AWS_US_WEST_1"},
+		Status:       "ENABLED",
+		SLAThreshold: 7,
+		Options: syntheticsMonitorExportOptions{
+			ValidationString: "${ok}",
+			VerifySSL:        true,
+		},
+	}
+
+	expected := `resource "newrelic_synthetics_monitor" "foo" {
+  name          = "foo"
+  type          = "SIMPLE"
+  frequency     = 5
+  status        = "ENABLED"
+  locations     = ["AWS_US_EAST_1", "AWS_US_WEST_1"]
+  sla_threshold = 7
+
+  uri                       = "https://example.com"
+  validation_string         = "$${ok}"
+  bypass_head_request       = false
+  treat_redirect_as_failure = false
+
+  ssl {
+    verify_certificate = true
+  }
+}
+`
+
+	require.Equal(t, expected, syntheticsMonitorHCL("foo", export))
+}
+
+func TestSyntheticsMonitorHCL_Script(t *testing.T) {
+	export := &syntheticsMonitorExport{
+		Name:         "foo",
+		Type:         "SCRIPT_API",
+		Frequency:    5,
+		Locations:    []string{"AWS_US_EAST_1"},
+		Status:       "ENABLED",
+		SLAThreshold: 7,
+		Script:       "// EOT\nconsole.log(`${1}`);\n",
+	}
+
+	expected := `resource "newrelic_synthetics_monitor" "foo" {
+  name          = "foo"
+  type          = "SCRIPT_API"
+  frequency     = 5
+  status        = "ENABLED"
+  locations     = ["AWS_US_EAST_1"]
+  sla_threshold = 7
+}
+
+resource "newrelic_synthetics_monitor_script" "foo" {
+  monitor_id = newrelic_synthetics_monitor.foo.id
+  text       = <<EOT1
+// EOT
+console.log(` + "`$${1}`" + `);
+EOT1
+}
+`
+
+	require.Equal(t, expected, syntheticsMonitorHCL("foo", export))
+}
+
+func TestHCLHeredoc_NoTrailingNewline(t *testing.T) {
+	require.Equal(t, `"a\nb"`, hclHeredoc("a\nb"))
+	require.Equal(t, "<<EOT\na\nb\nEOT", hclHeredoc("a\nb\n"))
+}
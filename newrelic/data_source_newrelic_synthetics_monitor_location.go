@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,13 +44,34 @@ func dataSourceNewRelicSyntheticsMonitorLocation() *schema.Resource {
 	}
 }
 
+// syntheticsMonitorLocationsCacheTTL is how long the locations list is cached.
+// Locations are rarely added, so it outlives the default.
+const syntheticsMonitorLocationsCacheTTL = 30 * time.Minute
+
+// listSyntheticsMonitorLocations lists the synthetics locations through the
+// provider's shared metadata cache, so data sources looking up several
+// locations list them once.
+func listSyntheticsMonitorLocations(ctx context.Context, providerConfig *ProviderConfig) ([]*synthetics.MonitorLocation, error) {
+	locations, err := providerConfig.metadataCache.getWithTTL("synthetics-locations", syntheticsMonitorLocationsCacheTTL, func() (interface{}, error) {
+		ctx, cancel := providerConfig.metadataFetchContext(ctx)
+		defer cancel()
+
+		return providerConfig.NewClient.Synthetics.GetMonitorLocationsWithContext(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return locations.([]*synthetics.MonitorLocation), nil
+}
+
 func dataSourceNewRelicSyntheticsMonitorLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ProviderConfig).NewClient
+	providerConfig := meta.(*ProviderConfig)
 
 	log.Printf("[INFO] Reading Synthetics monitor locations")
 
 	label := d.Get("label").(string)
-	locations, err := client.Synthetics.GetMonitorLocationsWithContext(ctx)
+	locations, err := listSyntheticsMonitorLocations(ctx, providerConfig)
 	if err != nil {
 		return diag.FromErr(err)
 	}
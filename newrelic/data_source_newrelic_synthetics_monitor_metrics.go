@@ -0,0 +1,97 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/pkg/nrdb"
+)
+
+var nrqlSinceRegexp = regexp.MustCompile(`^[1-9][0-9]* (minute|hour|day|week)s?$`)
+
+func dataSourceNewRelicSyntheticsMonitorMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsMonitorMetricsRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The New Relic account ID of the monitor.",
+			},
+			"monitor_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the synthetics monitor.",
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1 day",
+				ValidateFunc: validation.StringMatch(nrqlSinceRegexp, "must be a number followed by minutes, hours, days or weeks, e.g. \"6 hours\""),
+				Description:  "The window to aggregate over, ending now, e.g. \"6 hours\".",
+			},
+			"check_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of checks run in the window.",
+			},
+			"success_rate": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The percentage of successful checks in the window. 0 when no checks ran.",
+			},
+			"average_duration": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The average check duration in the window, in milliseconds. 0 when no checks ran.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsMonitorMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+	monitorID := d.Get("monitor_id").(string)
+	since := d.Get("since").(string)
+
+	log.Printf("[INFO] Reading New Relic Synthetics monitor %s metrics since %s ago", monitorID, since)
+
+	query := fmt.Sprintf("SELECT count(*) AS 'checks', percentage(count(*), WHERE result = 'SUCCESS') AS 'successRate', average(duration) AS 'averageDuration' FROM SyntheticCheck WHERE monitorId = '%s' SINCE %s AGO",
+		strings.ReplaceAll(monitorID, "'", "\\'"), since)
+
+	result, err := providerConfig.NewClient.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(query))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var row nrdb.NRDBResult
+	if len(result.Results) > 0 {
+		row = result.Results[0]
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", monitorID, since))
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("check_count", int(nrdbResultFloat(row, "checks")))
+	_ = d.Set("success_rate", nrdbResultFloat(row, "successRate"))
+	_ = d.Set("average_duration", nrdbResultFloat(row, "averageDuration"))
+
+	return nil
+}
+
+// nrdbResultFloat returns a numeric NRQL result, or 0 when the query matched
+// no events and the function returned null.
+func nrdbResultFloat(row nrdb.NRDBResult, key string) float64 {
+	if v, ok := row[key].(float64); ok {
+		return v
+	}
+
+	return 0
+}
@@ -0,0 +1,52 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceNewRelicSyntheticsMonitorMetricsRead(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Contains(t, req.Variables["query"], "WHERE monitorId = 'abc-123' SINCE 6 hours AGO")
+
+		_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"nrql":{"results":[{"checks":72,"successRate":97.5,"averageDuration":812.4}]}}}}}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicSyntheticsMonitorMetrics().Schema, map[string]interface{}{
+		"monitor_id": "abc-123",
+		"since":      "6 hours",
+	})
+
+	diags := dataSourceNewRelicSyntheticsMonitorMetricsRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, 72, d.Get("check_count"))
+	require.Equal(t, 97.5, d.Get("success_rate"))
+	require.Equal(t, 812.4, d.Get("average_duration"))
+}
+
+func TestDataSourceNewRelicSyntheticsMonitorMetricsRead_NoChecks(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"nrql":{"results":[{"checks":0,"successRate":null,"averageDuration":null}]}}}}}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicSyntheticsMonitorMetrics().Schema, map[string]interface{}{
+		"monitor_id": "abc-123",
+	})
+
+	diags := dataSourceNewRelicSyntheticsMonitorMetricsRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, 0, d.Get("check_count"))
+	require.Equal(t, 0.0, d.Get("success_rate"))
+}
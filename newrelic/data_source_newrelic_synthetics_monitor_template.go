@@ -0,0 +1,91 @@
+package newrelic
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+// dataSourceNewRelicSyntheticsMonitorTemplate holds settings shared by several
+// monitors. It makes no API calls: its attributes are the configured values,
+// validated like the monitor's, for monitors to reference. Unset attributes
+// stay null so monitors fall back to their own defaults.
+func dataSourceNewRelicSyntheticsMonitorTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsMonitorTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"frequency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(syntheticsMonitorFrequencies),
+				Description:  "The interval (in minutes) at which the monitors should run.",
+			},
+			"locations": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    1,
+				Optional:    true,
+				Description: "The locations in which the monitors should run.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "MUTED", "DISABLED"}, false),
+				Description:  "The monitor status (i.e. ENABLED, MUTED, DISABLED).",
+			},
+			"sla_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The base threshold (in seconds) to calculate the apdex score for use in the SLA report.",
+			},
+			"validation_string": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The string to validate against in the response.",
+			},
+			"verify_certificate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Validate the certificate chain and host name of the monitored endpoint, for the monitor's ssl block.",
+			},
+			"bypass_head_request": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Bypass HEAD request.",
+			},
+			"treat_redirect_as_failure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Fail the monitor check if redirected.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsMonitorTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	locations := []string{}
+	for _, l := range d.Get("locations").(*schema.Set).List() {
+		locations = append(locations, l.(string))
+	}
+
+	// The ID only needs to change with the settings, so reuse the monitor
+	// checksum over them.
+	d.SetId(syntheticsMonitorChecksum(&synthetics.Monitor{
+		Frequency:    uint(d.Get("frequency").(int)),
+		Locations:    locations,
+		Status:       synthetics.MonitorStatusType(d.Get("status").(string)),
+		SLAThreshold: d.Get("sla_threshold").(float64),
+		Options: synthetics.MonitorOptions{
+			ValidationString:       d.Get("validation_string").(string),
+			VerifySSL:              d.Get("verify_certificate").(bool),
+			BypassHEADRequest:      d.Get("bypass_head_request").(bool),
+			TreatRedirectAsFailure: d.Get("treat_redirect_as_failure").(bool),
+		},
+	}))
+
+	return nil
+}
@@ -0,0 +1,28 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceNewRelicSyntheticsMonitorTemplateRead(t *testing.T) {
+	read := func(raw map[string]interface{}) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, dataSourceNewRelicSyntheticsMonitorTemplate().Schema, raw)
+		require.False(t, dataSourceNewRelicSyntheticsMonitorTemplateRead(context.Background(), d, nil).HasError())
+		return d
+	}
+
+	a := read(map[string]interface{}{"frequency": 5, "locations": []interface{}{"AWS_US_EAST_1", "AWS_US_WEST_1"}})
+	b := read(map[string]interface{}{"frequency": 5, "locations": []interface{}{"AWS_US_WEST_1", "AWS_US_EAST_1"}})
+	c := read(map[string]interface{}{"frequency": 10, "locations": []interface{}{"AWS_US_EAST_1", "AWS_US_WEST_1"}})
+
+	require.Equal(t, 5, a.Get("frequency"))
+	require.Equal(t, a.Id(), b.Id())
+	require.NotEqual(t, a.Id(), c.Id())
+}
@@ -31,6 +31,25 @@ func TestAccNewRelicSyntheticsMonitorDataSource_Basic(t *testing.T) {
 	})
 }
 
+func TestAccNewRelicSyntheticsMonitorDataSource_ByGUID(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-synthetic-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNewRelicSyntheticsDataSourceConfigByGUID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.newrelic_synthetics_monitor.baz", "name", rName),
+					resource.TestCheckResourceAttrPair("data.newrelic_synthetics_monitor.baz", "monitor_id", "newrelic_synthetics_monitor.foo", "id"),
+					resource.TestCheckResourceAttr("data.newrelic_synthetics_monitor.baz", "type", "SIMPLE"),
+				),
+			},
+		},
+	})
+}
+
 func testAccNewRelicSyntheticsDataSource(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		r := s.RootModule().Resources[n]
@@ -64,3 +83,11 @@ data "newrelic_synthetics_monitor" "bar" {
 }
 `, name)
 }
+
+func testAccCheckNewRelicSyntheticsDataSourceConfigByGUID(name string) string {
+	return testAccCheckNewRelicSyntheticsDataSourceConfig(name) + `
+data "newrelic_synthetics_monitor" "baz" {
+	guid = data.newrelic_synthetics_monitor.bar.guid
+}
+`
+}
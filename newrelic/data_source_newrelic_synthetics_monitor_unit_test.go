@@ -0,0 +1,50 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceNewRelicSyntheticsMonitorRead_GUID(t *testing.T) {
+	// The monitor belongs to another account than the provider's.
+	guid := syntheticsMonitorGUID(456, "abc-123")
+
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(`{"data":{"actor":{"entity":{"__typename":"SyntheticMonitorEntity","guid":"` + string(guid) + `","monitorId":"abc-123"}}}}`))
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/monitors/abc-123") {
+			_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":5,"status":"ENABLED","locations":["AWS_US_EAST_1"]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"monitors":[{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":5,"status":"ENABLED","locations":["AWS_US_EAST_1"]}],"count":1}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"guid": string(guid),
+	})
+
+	diags := dataSourceNewRelicSyntheticsMonitorRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, "abc-123", d.Id())
+	require.Equal(t, "foo", d.Get("name"))
+	require.Equal(t, string(guid), d.Get("guid"))
+
+	// Monitors found by name get the GUID of the provider's account.
+	d = schema.TestResourceDataRaw(t, dataSourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name": "foo",
+	})
+
+	diags = dataSourceNewRelicSyntheticsMonitorRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, string(syntheticsMonitorGUID(123, "abc-123")), d.Get("guid"))
+}
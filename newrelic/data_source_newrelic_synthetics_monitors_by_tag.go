@@ -0,0 +1,144 @@
+package newrelic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// syntheticsMonitorTypeTag is the tag New Relic adds to monitor entities
+// with the monitor's type, e.g. SIMPLE.
+const syntheticsMonitorTypeTag = "monitorType"
+
+func dataSourceNewRelicSyntheticsMonitorsByTag() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsMonitorsByTagRead,
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Only return monitors carrying this tag. All tag filters must match.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Description:  "The tag key.",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Required:    true,
+							MinItems:    1,
+							Description: "The tag values, any of which matches.",
+						},
+					},
+				},
+			},
+			"guids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The GUIDs of the matching monitors.",
+			},
+			"monitors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching monitors.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The entity GUID of the monitor.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the monitor.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The monitor type, e.g. SIMPLE.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsMonitorsByTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+
+	log.Printf("[INFO] Reading New Relic Synthetics monitors by tag")
+
+	query := syntheticsMonitorTagValuesQuery(expandEntitySearchTagFilters(d.Get("tag").([]interface{})))
+
+	searchCtx, cancel := providerConfig.operationContext(ctx, operationSearch)
+	defer cancel()
+
+	results, err := searchEntitiesWithTags(searchCtx, providerConfig.NewClient, query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].GUID < results[j].GUID
+	})
+
+	guids := []string{}
+	monitors := []interface{}{}
+	for _, m := range results {
+		monitorType := ""
+		for _, t := range m.Tags {
+			if t.Key == syntheticsMonitorTypeTag && len(t.Values) > 0 {
+				monitorType = t.Values[0]
+			}
+		}
+
+		guids = append(guids, string(m.GUID))
+		monitors = append(monitors, map[string]interface{}{
+			"guid": string(m.GUID),
+			"name": m.Name,
+			"type": monitorType,
+		})
+	}
+
+	sum := sha256.Sum256([]byte(query))
+	d.SetId(hex.EncodeToString(sum[:]))
+
+	if err := d.Set("guids", guids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(d.Set("monitors", monitors))
+}
+
+// syntheticsMonitorTagValuesQuery builds the entity search query for the
+// monitors carrying, for every filter, one of its values.
+func syntheticsMonitorTagValuesQuery(filters []entitySearchTag) string {
+	clauses := []string{"domain = 'SYNTH'", "type = 'MONITOR'"}
+
+	for _, f := range filters {
+		values := make([]string, len(f.Values))
+		for i, v := range f.Values {
+			values[i] = "'" + strings.ReplaceAll(v, "'", "\\'") + "'"
+		}
+
+		clauses = append(clauses, fmt.Sprintf("tags.`%s` IN (%s)", f.Key, strings.Join(values, ", ")))
+	}
+
+	return strings.Join(clauses, " AND ")
+}
@@ -0,0 +1,54 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsMonitorTagValuesQuery(t *testing.T) {
+	query := syntheticsMonitorTagValuesQuery([]entitySearchTag{
+		{Key: "team", Values: []string{"checkout", "o'neil"}},
+		{Key: "env", Values: []string{"prod"}},
+	})
+
+	require.Equal(t, "domain = 'SYNTH' AND type = 'MONITOR' AND tags.`team` IN ('checkout', 'o\\'neil') AND tags.`env` IN ('prod')", query)
+}
+
+func TestDataSourceNewRelicSyntheticsMonitorsByTagRead(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), `"cursor":"next"`) {
+			_, _ = w.Write([]byte(`{"data":{"actor":{"entitySearch":{"results":{"nextCursor":null,"entities":[
+				{"guid":"BBB","name":"bar","tags":[{"key":"monitorType","values":["SCRIPT_API"]}]}
+			]}}}}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"actor":{"entitySearch":{"results":{"nextCursor":"next","entities":[
+			{"guid":"AAA","name":"foo","tags":[{"key":"monitorType","values":["SIMPLE"]}]}
+		]}}}}}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNewRelicSyntheticsMonitorsByTag().Schema, map[string]interface{}{
+		"tag": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"checkout"}},
+		},
+	})
+
+	diags := dataSourceNewRelicSyntheticsMonitorsByTagRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []interface{}{"AAA", "BBB"}, d.Get("guids"))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"guid": "AAA", "name": "foo", "type": "SIMPLE"},
+		map[string]interface{}{"guid": "BBB", "name": "bar", "type": "SCRIPT_API"},
+	}, d.Get("monitors"))
+}
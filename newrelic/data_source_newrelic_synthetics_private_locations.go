@@ -0,0 +1,145 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNewRelicSyntheticsPrivateLocations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicSyntheticsPrivateLocationsRead,
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only return private locations carrying this tag. All tag filters must match.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The tag key.",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Optional:    true,
+							Description: "The tag values that must all be present. If omitted, any value of the key matches.",
+						},
+					},
+				},
+			},
+			"guids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The GUIDs of the matching private locations.",
+			},
+			"locations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching private locations.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The GUID of the private location.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the private location.",
+						},
+						"account_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The New Relic account ID owning the private location.",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The tags of the private location.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicSyntheticsPrivateLocationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+
+	log.Printf("[INFO] Reading New Relic Synthetics private locations")
+
+	searchCtx, cancel := providerConfig.operationContext(ctx, operationSearch)
+	defer cancel()
+
+	results, err := searchEntitiesWithTags(searchCtx, providerConfig.NewClient, "domain = 'SYNTH' AND type = 'PRIVATE_LOCATION'")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters := expandEntitySearchTagFilters(d.Get("tag").([]interface{}))
+
+	guids := []string{}
+	locations := []interface{}{}
+	for _, l := range results {
+		if !entityHasTags(l, filters) {
+			continue
+		}
+
+		guids = append(guids, string(l.GUID))
+		locations = append(locations, map[string]interface{}{
+			"guid":       string(l.GUID),
+			"name":       l.Name,
+			"account_id": l.AccountID,
+			"tag":        flattenEntitySearchTags(l.Tags),
+		})
+	}
+
+	d.SetId(strconv.Itoa(providerConfig.AccountID))
+
+	if err := d.Set("guids", guids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(d.Set("locations", locations))
+}
+
+func expandEntitySearchTagFilters(cfg []interface{}) []entitySearchTag {
+	filters := make([]entitySearchTag, 0, len(cfg))
+
+	for _, raw := range cfg {
+		t := raw.(map[string]interface{})
+		filter := entitySearchTag{
+			Key: t["key"].(string),
+		}
+
+		for _, v := range t["values"].([]interface{}) {
+			filter.Values = append(filter.Values, v.(string))
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters
+}
@@ -0,0 +1,128 @@
+package newrelic
+
+import (
+	"context"
+
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/common"
+)
+
+// The entity search in newrelic-client-go neither returns tags nor supports
+// paging through results, so entity searches that need either go through
+// NerdGraph directly.
+const entitySearchWithTagsQuery = `query($query: String!, $cursor: String) {
+	actor {
+		entitySearch(query: $query) {
+			results(cursor: $cursor) {
+				nextCursor
+				entities {
+					accountId
+					guid
+					name
+					type
+					tags {
+						key
+						values
+					}
+				}
+			}
+		}
+	}
+}`
+
+type entitySearchTag struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+type entitySearchEntity struct {
+	AccountID int               `json:"accountId"`
+	GUID      common.EntityGUID `json:"guid"`
+	Name      string            `json:"name"`
+	Type      string            `json:"type"`
+	Tags      []entitySearchTag `json:"tags"`
+}
+
+type entitySearchWithTagsResponse struct {
+	Actor struct {
+		EntitySearch struct {
+			Results struct {
+				NextCursor *string              `json:"nextCursor"`
+				Entities   []entitySearchEntity `json:"entities"`
+			} `json:"results"`
+		} `json:"entitySearch"`
+	} `json:"actor"`
+}
+
+// searchEntitiesWithTags runs an entity search query (e.g. "domain = 'SYNTH'")
+// and returns every matching entity along with its tags, following the
+// result cursor until all pages have been read.
+func searchEntitiesWithTags(ctx context.Context, client *nr.NewRelic, query string) ([]entitySearchEntity, error) {
+	var results []entitySearchEntity
+	var cursor *string
+
+	for {
+		// The client doesn't stop a request when ctx is done, so check it
+		// between pages.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		resp := entitySearchWithTagsResponse{}
+		vars := map[string]interface{}{
+			"query":  query,
+			"cursor": cursor,
+		}
+
+		if err := client.NerdGraph.QueryWithResponseAndContext(ctx, entitySearchWithTagsQuery, vars, &resp); err != nil {
+			return nil, err
+		}
+
+		page := resp.Actor.EntitySearch.Results
+		results = append(results, page.Entities...)
+
+		if page.NextCursor == nil || *page.NextCursor == "" {
+			return results, nil
+		}
+
+		cursor = page.NextCursor
+	}
+}
+
+// entityHasTags reports whether the entity carries every one of the given
+// tags. A filter with no values matches any value of the key.
+func entityHasTags(entity entitySearchEntity, filters []entitySearchTag) bool {
+	for _, f := range filters {
+		var tag *entitySearchTag
+		for i := range entity.Tags {
+			if entity.Tags[i].Key == f.Key {
+				tag = &entity.Tags[i]
+				break
+			}
+		}
+
+		if tag == nil {
+			return false
+		}
+
+		for _, v := range f.Values {
+			if !stringInSlice(tag.Values, v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func flattenEntitySearchTags(tags []entitySearchTag) []interface{} {
+	out := make([]interface{}, len(tags))
+	for i, t := range tags {
+		out[i] = map[string]interface{}{
+			"key":    t.Key,
+			"values": t.Values,
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,52 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityHasTags(t *testing.T) {
+	entity := entitySearchEntity{
+		Name: "prod-location",
+		Tags: []entitySearchTag{
+			{Key: "env", Values: []string{"prod", "shared"}},
+			{Key: "team", Values: []string{"platform"}},
+		},
+	}
+
+	require.True(t, entityHasTags(entity, nil))
+	require.True(t, entityHasTags(entity, []entitySearchTag{{Key: "env", Values: []string{"prod"}}}))
+	require.True(t, entityHasTags(entity, []entitySearchTag{{Key: "team"}}))
+	require.True(t, entityHasTags(entity, []entitySearchTag{
+		{Key: "env", Values: []string{"prod", "shared"}},
+		{Key: "team", Values: []string{"platform"}},
+	}))
+
+	require.False(t, entityHasTags(entity, []entitySearchTag{{Key: "env", Values: []string{"staging"}}}))
+	require.False(t, entityHasTags(entity, []entitySearchTag{{Key: "region"}}))
+	require.False(t, entityHasTags(entity, []entitySearchTag{
+		{Key: "env", Values: []string{"prod"}},
+		{Key: "team", Values: []string{"payments"}},
+	}))
+}
+
+func TestSearchEntitiesWithTags_ContextDone(t *testing.T) {
+	requests := 0
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"data":{"actor":{"entitySearch":{"results":{"entities":[],"nextCursor":"next"}}}}}`))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := searchEntitiesWithTags(ctx, providerConfig.NewClient, syntheticsMonitorTagQuery(nil))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Zero(t, requests)
+}
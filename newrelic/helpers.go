@@ -108,6 +108,28 @@ func stringInSlice(slice []string, str string) bool {
 	return false
 }
 
+func intSliceContains(slice []int, i int) bool {
+	for _, v := range slice {
+		if i == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// escapeNRQLString escapes backslashes and single quotes so s can be embedded
+// in a quoted NRQL string literal.
+func escapeNRQLString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(s)
+}
+
+// escapeNRQLIdentifier escapes backslashes and backticks so s can be embedded
+// in a backtick-quoted NRQL identifier.
+func escapeNRQLIdentifier(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(s)
+}
+
 func updateContextWithAccountID(ctx context.Context, accountID int) context.Context {
 	if accountID > 0 {
 		log.Printf("[INFO] Adding Account ID to X-Account-ID context %v", accountID)
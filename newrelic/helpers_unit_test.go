@@ -63,3 +63,14 @@ func TestSortIntegerSlice(t *testing.T) {
 
 	require.Equal(t, expected, integers)
 }
+
+func TestEscapeNRQLString(t *testing.T) {
+	require.Equal(t, "abc-123", escapeNRQLString("abc-123"))
+	require.Equal(t, `abc\' OR monitorId = \'def`, escapeNRQLString("abc' OR monitorId = 'def"))
+	require.Equal(t, `abc\\\' OR 1`, escapeNRQLString(`abc\' OR 1`))
+}
+
+func TestEscapeNRQLIdentifier(t *testing.T) {
+	require.Equal(t, "team", escapeNRQLIdentifier("team"))
+	require.Equal(t, "te\\`am\\\\", escapeNRQLIdentifier("te`am\\"))
+}
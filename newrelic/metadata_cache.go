@@ -0,0 +1,113 @@
+package newrelic
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const defaultMetadataCacheTTL = 5 * time.Minute
+
+// metadataCache caches account metadata, such as entity lookups and the
+// synthetics locations, for the lifetime of a provider. Concurrent lookups of
+// the same key share a single API call, so resources resolving the same
+// metadata in parallel don't each hit the API. Failed lookups are not cached.
+type metadataCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*metadataCacheEntry
+}
+
+type metadataCacheEntry struct {
+	done    chan struct{}
+	expires time.Time
+	value   interface{}
+	err     error
+}
+
+// get returns the cached value for key, calling fetch to populate it when it
+// is missing or expired.
+func (c *metadataCache) get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	return c.getWithTTL(key, 0, fetch)
+}
+
+// getWithTTL is like get, but caches a fetched value for ttl rather than the
+// cache's default.
+func (c *metadataCache) getWithTTL(key string, ttl time.Duration, fetch func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+
+	if c.entries == nil {
+		c.entries = map[string]*metadataCacheEntry{}
+	}
+
+	if e, ok := c.entries[key]; ok {
+		select {
+		case <-e.done:
+			if time.Now().Before(e.expires) {
+				c.mu.Unlock()
+				return e.value, nil
+			}
+		default:
+			// A lookup is in flight; wait for its result.
+			c.mu.Unlock()
+			<-e.done
+			return e.value, e.err
+		}
+	}
+
+	e := &metadataCacheEntry{done: make(chan struct{})}
+	c.entries[key] = e
+	c.mu.Unlock()
+
+	e.value, e.err = fetch()
+
+	if ttl == 0 {
+		ttl = c.ttl
+	}
+	if ttl == 0 {
+		ttl = defaultMetadataCacheTTL
+	}
+
+	c.mu.Lock()
+	e.expires = time.Now().Add(ttl)
+	if e.err != nil && c.entries[key] == e {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+
+	close(e.done)
+
+	return e.value, e.err
+}
+
+// metadataFetchContext returns the context to fetch a metadata cache entry
+// with. Every caller waiting on the key shares the fetch, so it keeps ctx's
+// values but not its cancellation, which would fail the other callers too.
+// It carries the provider's read timeout, but the client doesn't stop a
+// request in flight when it expires, so the fetch can last as long as the
+// client's own HTTP timeout.
+func (c *ProviderConfig) metadataFetchContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return c.operationContext(detachedContext{ctx}, operationRead)
+}
+
+// detachedContext carries the values of its parent but is never canceled.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (detachedContext) Done() <-chan struct{} { return nil }
+
+func (detachedContext) Err() error { return nil }
+
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+// invalidate drops the cached value for key, e.g. after changing what it
+// describes, so the next lookup fetches it again. Lookups already in flight
+// still return their result.
+func (c *metadataCache) invalidate(key string) {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+}
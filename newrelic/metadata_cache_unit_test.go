@@ -0,0 +1,186 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataCache_CoalescesConcurrentLookups(t *testing.T) {
+	var c metadataCache
+	var calls int32
+
+	release := make(chan struct{})
+	fetch := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "abc-123", nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]interface{}, 10)
+	errs := make([]error, 10)
+
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			results[i], errs[i] = c.get("guid", fetch)
+		}(i)
+	}
+
+	// Give the goroutines time to queue up behind the first lookup.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for i, v := range results {
+		require.NoError(t, errs[i])
+		require.Equal(t, "abc-123", v)
+	}
+}
+
+func TestMetadataCache_Expiry(t *testing.T) {
+	c := metadataCache{ttl: 10 * time.Millisecond}
+	var calls int32
+
+	fetch := func() (interface{}, error) {
+		return atomic.AddInt32(&calls, 1), nil
+	}
+
+	v, err := c.get("guid", fetch)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), v)
+
+	v, err = c.get("guid", fetch)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), v)
+
+	time.Sleep(20 * time.Millisecond)
+
+	v, err = c.get("guid", fetch)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), v)
+}
+
+func TestMetadataCache_ErrorsNotCached(t *testing.T) {
+	var c metadataCache
+	var calls int32
+
+	fetch := func() (interface{}, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return nil, errors.New("boom")
+		}
+		return "abc-123", nil
+	}
+
+	_, err := c.get("guid", fetch)
+	require.Error(t, err)
+
+	v, err := c.get("guid", fetch)
+	require.NoError(t, err)
+	require.Equal(t, "abc-123", v)
+}
+
+func TestMetadataCache_PerEntryTTL(t *testing.T) {
+	c := metadataCache{ttl: time.Hour}
+	var calls int32
+
+	fetch := func() (interface{}, error) {
+		return atomic.AddInt32(&calls, 1), nil
+	}
+
+	v, err := c.getWithTTL("locations", 10*time.Millisecond, fetch)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), v)
+
+	v, err = c.get("guid", fetch)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), v)
+
+	time.Sleep(20 * time.Millisecond)
+
+	// The short-lived entry expired, the other one uses the cache's TTL.
+	v, err = c.getWithTTL("locations", 10*time.Millisecond, fetch)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), v)
+
+	v, err = c.get("guid", fetch)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), v)
+}
+
+func TestMetadataCache_Invalidate(t *testing.T) {
+	var c metadataCache
+	var calls int32
+
+	fetch := func() (interface{}, error) {
+		return atomic.AddInt32(&calls, 1), nil
+	}
+
+	v, err := c.get("credentials", fetch)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), v)
+
+	c.invalidate("credentials")
+	c.invalidate("missing")
+
+	v, err = c.get("credentials", fetch)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), v)
+}
+
+func TestMetadataCache_ConcurrentAccess(t *testing.T) {
+	c := metadataCache{ttl: time.Millisecond}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			key := []string{"a", "b", "c"}[i%3]
+			for j := 0; j < 20; j++ {
+				v, err := c.get(key, func() (interface{}, error) {
+					return key, nil
+				})
+				require.NoError(t, err)
+				require.Equal(t, key, v)
+
+				if j%5 == 0 {
+					c.invalidate(key)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestProviderConfigMetadataFetchContext(t *testing.T) {
+	type key struct{}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "value"))
+	cancel()
+
+	// A fetch shared with other callers outlives the caller that started it.
+	fetchCtx, fetchCancel := (&ProviderConfig{}).metadataFetchContext(ctx)
+	defer fetchCancel()
+	require.NoError(t, fetchCtx.Err())
+	require.Equal(t, "value", fetchCtx.Value(key{}))
+
+	// It is bounded by the read timeout instead.
+	providerConfig := &ProviderConfig{OperationTimeouts: map[string]time.Duration{operationRead: time.Millisecond}}
+	fetchCtx, fetchCancel = providerConfig.metadataFetchContext(ctx)
+	defer fetchCancel()
+	<-fetchCtx.Done()
+	require.ErrorIs(t, fetchCtx.Err(), context.DeadlineExceeded)
+}
@@ -3,7 +3,9 @@ package newrelic
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -87,6 +89,198 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NEW_RELIC_NERDGRAPH_API_URL", nil),
 			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Overrides for the service-specific base URLs used by the New Relic client, e.g. for isolated New Relic instances.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"synthetics": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The base URL of the Synthetics API.",
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"nerdgraph": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The base URL of the NerdGraph API.",
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+			"operation_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Timeouts for operations by type, as durations such as \"30s\" or \"2m\". Applies within the resource timeouts. A timeout stops the provider's waits and retries, not a single API request in flight.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"search": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The timeout of entity searches.",
+							ValidateFunc: validatePositiveDuration(),
+						},
+						"create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The timeout of creating a Synthetics monitor.",
+							ValidateFunc: validatePositiveDuration(),
+						},
+						"read": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The timeout of reading a Synthetics monitor.",
+							ValidateFunc: validatePositiveDuration(),
+						},
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The timeout of updating a Synthetics monitor.",
+							ValidateFunc: validatePositiveDuration(),
+						},
+						"delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The timeout of deleting a Synthetics monitor.",
+							ValidateFunc: validatePositiveDuration(),
+						},
+					},
+				},
+			},
+			"account_credentials": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "API keys for additional accounts. Resources whose account_id matches one of these accounts use its key instead of api_key.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The New Relic account ID.",
+						},
+						"api_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The Personal API key used for the account.",
+						},
+					},
+				},
+			},
+			"default_synthetics_locations": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "The locations used by Synthetics monitors that don't specify their own.",
+			},
+			"synthetics_location_group": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A named set of Synthetics locations that monitors can reference through location_group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name monitors reference the group by.",
+						},
+						"locations": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Required:    true,
+							MinItems:    1,
+							Description: "The public and private locations of the group.",
+						},
+					},
+				},
+			},
+			"default_frequency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(syntheticsMonitorFrequencies),
+				Description:  "The frequency (in minutes) used by Synthetics monitors that don't specify their own.",
+			},
+			"default_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "MUTED", "DISABLED"}, false),
+				Description:  "The status used by Synthetics monitors that don't specify their own.",
+			},
+			"default_sla_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0.001),
+				Description:  "The SLA threshold (in seconds) used by Synthetics monitors that don't specify their own. Defaults to 7.",
+			},
+			"workspace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_WORKSPACE", ""),
+				Description: "The Terraform workspace, used to select the locations_by_workspace of Synthetics monitors. Set it to terraform.workspace.",
+			},
+			"monitor_name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "A prefix prepended to the name of every Synthetics monitor whose name doesn't already start with it.",
+			},
+			"default_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags applied to every Synthetics monitor managed by the provider. Tags set on a monitor override defaults with the same key.",
+			},
+			"validate_locations_offline": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Validate Synthetics monitor locations at plan time against the provider's built-in list of public locations instead of the API.",
+			},
+			"validate_secure_credential_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Check at plan time that the secure credentials referenced as $secure.<KEY> in Synthetics monitor scripts exist.",
+			},
+			"check_private_location_capacity": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Warn when a Synthetics monitor's frequency likely exceeds the minion capacity of its private locations.",
+			},
+			"plan_verify_live": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Warn when refreshing a Synthetics monitor finds attributes changed outside of Terraform, including changes Terraform would otherwise ignore.",
+			},
+			"batch_synthetics_monitor_reads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List all Synthetics monitors in a single paginated request on the first monitor read, and serve refreshes from that listing.",
+			},
+			"strict_synthetics_monitor_options": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send Synthetics monitor options exactly as configured, without defaulting bypass_head_request to true for monitors with a validation string.",
+			},
+			"synthetics_monitor_operations_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Write a JSON summary of the Synthetics monitors created, updated and deleted by an apply to this path.",
+			},
+			"recreate_on_update_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replace a Synthetics monitor with a new one when the API rejects an update because a changed field can't be updated in place.",
+			},
 			"insights_insert_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -103,6 +297,13 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NEW_RELIC_INSIGHTS_QUERY_URL", insightsQueryURL),
 			},
+			"newrelic_log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NEW_RELIC_LOG_LEVEL", nil),
+				Description:  "The log level of the New Relic client (trace, debug, info, warn or error). Defaults to the Terraform log level.",
+				ValidateFunc: validation.StringInSlice([]string{"trace", "debug", "info", "warn", "error"}, true),
+			},
 			"insecure_skip_verify": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -121,12 +322,24 @@ func Provider() *schema.Provider {
 			"newrelic_alert_policy":                 dataSourceNewRelicAlertPolicy(),
 			"newrelic_application":                  dataSourceNewRelicApplication(),
 			"newrelic_cloud_account":                dataSourceNewRelicCloudAccount(),
+			"newrelic_cloud_linked_accounts":        dataSourceNewRelicCloudLinkedAccounts(),
 			"newrelic_entity":                       dataSourceNewRelicEntity(),
 			"newrelic_key_transaction":              dataSourceNewRelicKeyTransaction(),
 			"newrelic_plugin":                       dataSourceNewRelicPlugin(),
 			"newrelic_plugin_component":             dataSourceNewRelicPluginComponent(),
+			"newrelic_provider_health":              dataSourceNewRelicProviderHealth(),
+			"newrelic_synthetics_backup":            dataSourceNewRelicSyntheticsBackup(),
+			"newrelic_synthetics_coverage":          dataSourceNewRelicSyntheticsCoverage(),
+			"newrelic_synthetics_duplicates":        dataSourceNewRelicSyntheticsDuplicates(),
+			"newrelic_synthetics_location_group":    dataSourceNewRelicSyntheticsLocationGroup(),
 			"newrelic_synthetics_monitor":           dataSourceNewRelicSyntheticsMonitor(),
+			"newrelic_synthetics_monitor_export":    dataSourceNewRelicSyntheticsMonitorExport(),
+			"newrelic_synthetics_monitor_hcl":       dataSourceNewRelicSyntheticsMonitorHCL(),
 			"newrelic_synthetics_monitor_location":  dataSourceNewRelicSyntheticsMonitorLocation(),
+			"newrelic_synthetics_monitor_metrics":   dataSourceNewRelicSyntheticsMonitorMetrics(),
+			"newrelic_synthetics_monitor_template":  dataSourceNewRelicSyntheticsMonitorTemplate(),
+			"newrelic_synthetics_monitors_by_tag":   dataSourceNewRelicSyntheticsMonitorsByTag(),
+			"newrelic_synthetics_private_locations": dataSourceNewRelicSyntheticsPrivateLocations(),
 			"newrelic_synthetics_secure_credential": dataSourceNewRelicSyntheticsSecureCredential(),
 		},
 
@@ -158,8 +371,13 @@ func Provider() *schema.Provider {
 			"newrelic_plugins_alert_condition":                  resourceNewRelicPluginsAlertCondition(),
 			"newrelic_service_level":                            resourceNewRelicServiceLevel(),
 			"newrelic_synthetics_alert_condition":               resourceNewRelicSyntheticsAlertCondition(),
+			"newrelic_synthetics_budget_alert":                  resourceNewRelicSyntheticsBudgetAlert(),
+			"newrelic_synthetics_location_migration":            resourceNewRelicSyntheticsLocationMigration(),
 			"newrelic_synthetics_monitor":                       resourceNewRelicSyntheticsMonitor(),
+			"newrelic_synthetics_monitor_cleanup":               resourceNewRelicSyntheticsMonitorCleanup(),
 			"newrelic_synthetics_monitor_script":                resourceNewRelicSyntheticsMonitorScript(),
+			"newrelic_synthetics_monitor_set":                   resourceNewRelicSyntheticsMonitorSet(),
+			"newrelic_synthetics_monitor_status":                resourceNewRelicSyntheticsMonitorStatus(),
 			"newrelic_synthetics_multilocation_alert_condition": resourceNewRelicSyntheticsMultiLocationAlertCondition(),
 			"newrelic_synthetics_secure_credential":             resourceNewRelicSyntheticsSecureCredential(),
 			"newrelic_workload":                                 resourceNewRelicWorkload(),
@@ -198,10 +416,14 @@ func providerConfigure(data *schema.ResourceData, terraformVersion string) (inte
 		userAgent:            userAgent,
 		InsecureSkipVerify:   data.Get("insecure_skip_verify").(bool),
 		CACertFile:           data.Get("cacert_file").(string),
+		LogLevel:             data.Get("newrelic_log_level").(string),
 	}
+
+	expandProviderEndpoints(data, &cfg)
+
 	log.Println("[INFO] Initializing newrelic-client-go")
 
-	client, err := cfg.Client()
+	client, err := cfg.sharedClient()
 	if err != nil {
 		return nil, fmt.Errorf("error initializing newrelic-client-go: %w", err)
 	}
@@ -221,11 +443,125 @@ func providerConfigure(data *schema.ResourceData, terraformVersion string) (inte
 		InsightsInsertClient: clientInsightsInsert,
 		PersonalAPIKey:       personalAPIKey,
 		AccountID:            accountID,
+		clientConfig:         cfg,
+		accountAPIKeys:       expandProviderAccountCredentials(data),
+	}
+
+	providerConfig.RecreateOnUpdateError = data.Get("recreate_on_update_error").(bool)
+	providerConfig.ValidateLocationsOffline = data.Get("validate_locations_offline").(bool)
+	providerConfig.BatchSyntheticsMonitorReads = data.Get("batch_synthetics_monitor_reads").(bool)
+	providerConfig.StrictSyntheticsMonitorOptions = data.Get("strict_synthetics_monitor_options").(bool)
+	providerConfig.ValidateSecureCredentialRefs = data.Get("validate_secure_credential_references").(bool)
+	providerConfig.CheckPrivateLocationCapacity = data.Get("check_private_location_capacity").(bool)
+	providerConfig.PlanVerifyLive = data.Get("plan_verify_live").(bool)
+	providerConfig.OperationTimeouts = expandProviderOperationTimeouts(data)
+	providerConfig.monitorOperations.path = data.Get("synthetics_monitor_operations_path").(string)
+
+	for k, v := range data.Get("default_tags").(map[string]interface{}) {
+		if providerConfig.DefaultTags == nil {
+			providerConfig.DefaultTags = map[string]string{}
+		}
+		providerConfig.DefaultTags[k] = v.(string)
+	}
+
+	providerConfig.DefaultSyntheticsFrequency = data.Get("default_frequency").(int)
+	providerConfig.DefaultSyntheticsStatus = data.Get("default_status").(string)
+	providerConfig.DefaultSyntheticsSLAThreshold = data.Get("default_sla_threshold").(float64)
+	providerConfig.Workspace = data.Get("workspace").(string)
+	providerConfig.MonitorNamePrefix = data.Get("monitor_name_prefix").(string)
+
+	for _, l := range data.Get("default_synthetics_locations").([]interface{}) {
+		providerConfig.DefaultSyntheticsLocations = append(providerConfig.DefaultSyntheticsLocations, l.(string))
+	}
+
+	providerConfig.SyntheticsLocationGroups, err = expandProviderSyntheticsLocationGroups(data)
+	if err != nil {
+		return nil, err
 	}
 
 	return &providerConfig, nil
 }
 
+func expandProviderSyntheticsLocationGroups(data *schema.ResourceData) (map[string][]string, error) {
+	groups := map[string][]string{}
+
+	for _, g := range data.Get("synthetics_location_group").([]interface{}) {
+		group := g.(map[string]interface{})
+		name := group["name"].(string)
+
+		if _, ok := groups[name]; ok {
+			return nil, fmt.Errorf("synthetics_location_group %q is defined more than once", name)
+		}
+
+		locations := []string{}
+		for _, l := range group["locations"].(*schema.Set).List() {
+			locations = append(locations, l.(string))
+		}
+		sort.Strings(locations)
+
+		groups[name] = locations
+	}
+
+	return groups, nil
+}
+
+// expandProviderEndpoints applies any `endpoints` overrides on top of the
+// (deprecated) flat API URL attributes.
+func expandProviderEndpoints(data *schema.ResourceData, cfg *Config) {
+	endpoints, ok := data.GetOk("endpoints")
+	if !ok {
+		return
+	}
+
+	raw := endpoints.([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return
+	}
+
+	e := raw[0].(map[string]interface{})
+
+	if v, ok := e["synthetics"].(string); ok && v != "" {
+		cfg.SyntheticsAPIURL = v
+	}
+
+	if v, ok := e["nerdgraph"].(string); ok && v != "" {
+		cfg.NerdGraphAPIURL = v
+	}
+}
+
+func expandProviderOperationTimeouts(data *schema.ResourceData) map[string]time.Duration {
+	timeouts := map[string]time.Duration{}
+
+	raw := data.Get("operation_timeouts").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return timeouts
+	}
+
+	for operation, v := range raw[0].(map[string]interface{}) {
+		// Values were validated at plan time.
+		if d, err := time.ParseDuration(v.(string)); err == nil {
+			timeouts[operation] = d
+		}
+	}
+
+	return timeouts
+}
+
+func expandProviderAccountCredentials(data *schema.ResourceData) map[int]string {
+	keys := map[int]string{}
+
+	for _, c := range data.Get("account_credentials").([]interface{}) {
+		if c == nil {
+			continue
+		}
+
+		credentials := c.(map[string]interface{})
+		keys[credentials["account_id"].(int)] = credentials["api_key"].(string)
+	}
+
+	return keys
+}
+
 func getInfraAPIURL(data *schema.ResourceData) string {
 	newURL, newURLOk := data.GetOk("infrastructure_api_url")
 
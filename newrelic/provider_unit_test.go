@@ -4,7 +4,17 @@
 package newrelic
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProvider(t *testing.T) {
@@ -25,3 +35,195 @@ func TestProviderConfig(t *testing.T) {
 		t.Error("hasNerdGraphCreds should be true")
 	}
 }
+
+func TestExpandProviderEndpoints(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"synthetics_api_url": "https://synthetics.example.com",
+		"endpoints": []interface{}{
+			map[string]interface{}{
+				"nerdgraph": "https://nerdgraph.example.com/graphql",
+			},
+		},
+	})
+
+	cfg := Config{
+		SyntheticsAPIURL: d.Get("synthetics_api_url").(string),
+	}
+
+	expandProviderEndpoints(d, &cfg)
+
+	require.Equal(t, "https://synthetics.example.com", cfg.SyntheticsAPIURL)
+	require.Equal(t, "https://nerdgraph.example.com/graphql", cfg.NerdGraphAPIURL)
+}
+
+func TestExpandProviderOperationTimeouts(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"operation_timeouts": []interface{}{
+			map[string]interface{}{
+				"search": "2m",
+				"read":   "15s",
+			},
+		},
+	})
+
+	providerConfig := ProviderConfig{OperationTimeouts: expandProviderOperationTimeouts(d)}
+	require.Equal(t, map[string]time.Duration{operationSearch: 2 * time.Minute, operationRead: 15 * time.Second}, providerConfig.OperationTimeouts)
+
+	ctx, cancel := providerConfig.operationContext(context.Background(), operationRead)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(15*time.Second), deadline, time.Second)
+
+	ctx, cancel = providerConfig.operationContext(context.Background(), operationDelete)
+	defer cancel()
+	_, ok = ctx.Deadline()
+	require.False(t, ok)
+}
+
+func TestProviderConfigClientForAccount(t *testing.T) {
+	cfg := Config{
+		PersonalAPIKey: "NRAK-DEFAULT",
+		Region:         "US",
+		userAgent:      "terraform-provider-newrelic-test",
+	}
+
+	client, err := cfg.Client()
+	require.NoError(t, err)
+
+	c := &ProviderConfig{
+		NewClient:      client,
+		AccountID:      123,
+		PersonalAPIKey: cfg.PersonalAPIKey,
+		clientConfig:   cfg,
+		accountAPIKeys: map[int]string{456: "NRAK-OTHER"},
+	}
+
+	defaultClient, err := c.clientForAccount(123)
+	require.NoError(t, err)
+	require.Same(t, client, defaultClient)
+
+	otherClient, err := c.clientForAccount(456)
+	require.NoError(t, err)
+	require.NotSame(t, client, otherClient)
+
+	cachedClient, err := c.clientForAccount(456)
+	require.NoError(t, err)
+	require.Same(t, otherClient, cachedClient)
+}
+
+// testMockProviderConfig returns a ProviderConfig whose client talks to
+// mock New Relic APIs served by handler.
+func testMockProviderConfig(t *testing.T, handler http.HandlerFunc) *ProviderConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := Config{
+		PersonalAPIKey:   "NRAK-TEST",
+		Region:           "US",
+		APIURL:           server.URL,
+		SyntheticsAPIURL: server.URL,
+		NerdGraphAPIURL:  server.URL,
+		userAgent:        "terraform-provider-newrelic-test",
+	}
+
+	client, err := cfg.Client()
+	require.NoError(t, err)
+
+	return &ProviderConfig{
+		NewClient:      client,
+		PersonalAPIKey: cfg.PersonalAPIKey,
+		AccountID:      123,
+	}
+}
+
+func TestExpandProviderSyntheticsLocationGroups(t *testing.T) {
+	group := func(name string, locations ...interface{}) map[string]interface{} {
+		return map[string]interface{}{"name": name, "locations": locations}
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"synthetics_location_group": []interface{}{
+			group("us", "AWS_US_WEST_1", "AWS_US_EAST_1"),
+			group("eu", "AWS_EU_WEST_1"),
+		},
+	})
+
+	groups, err := expandProviderSyntheticsLocationGroups(d)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"us": {"AWS_US_EAST_1", "AWS_US_WEST_1"},
+		"eu": {"AWS_EU_WEST_1"},
+	}, groups)
+
+	d = schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"synthetics_location_group": []interface{}{
+			group("us", "AWS_US_EAST_1"),
+			group("us", "AWS_US_WEST_1"),
+		},
+	})
+
+	_, err = expandProviderSyntheticsLocationGroups(d)
+	require.EqualError(t, err, `synthetics_location_group "us" is defined more than once`)
+}
+
+func TestConfigSharedClient(t *testing.T) {
+	cfg := Config{PersonalAPIKey: "NRAK-SHARED", Region: "US", userAgent: "terraform-provider-newrelic-test"}
+
+	first, err := cfg.sharedClient()
+	require.NoError(t, err)
+
+	same := cfg
+	second, err := same.sharedClient()
+	require.NoError(t, err)
+	require.Same(t, first, second)
+
+	other := cfg
+	other.PersonalAPIKey = "NRAK-OTHER"
+	third, err := other.sharedClient()
+	require.NoError(t, err)
+	require.NotSame(t, first, third)
+
+	key, err := cfg.clientPoolKey()
+	require.NoError(t, err)
+	require.NotContains(t, key, "NRAK-SHARED")
+}
+
+func TestConfigSharedClient_CACertFile(t *testing.T) {
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caCertFile, []byte("first"), 0600))
+
+	cfg := Config{PersonalAPIKey: "NRAK-CA", Region: "US", CACertFile: caCertFile, userAgent: "terraform-provider-newrelic-test"}
+
+	first, err := cfg.sharedClient()
+	require.NoError(t, err)
+
+	// A certificate replaced in place gets a new client.
+	require.NoError(t, os.WriteFile(caCertFile, []byte("second"), 0600))
+	second, err := cfg.sharedClient()
+	require.NoError(t, err)
+	require.NotSame(t, first, second)
+}
+
+func TestConfigSharedClient_Eviction(t *testing.T) {
+	cfg := Config{PersonalAPIKey: "NRAK-EVICTED", Region: "US", userAgent: "terraform-provider-newrelic-test"}
+
+	first, err := cfg.sharedClient()
+	require.NoError(t, err)
+
+	for i := 0; i < clientPoolSize; i++ {
+		other := cfg
+		other.PersonalAPIKey = fmt.Sprintf("NRAK-%d", i)
+		_, err := other.sharedClient()
+		require.NoError(t, err)
+	}
+
+	clientPool.Lock()
+	require.LessOrEqual(t, len(clientPool.clients), clientPoolSize)
+	clientPool.Unlock()
+
+	// The least recently used client was evicted.
+	again, err := cfg.sharedClient()
+	require.NoError(t, err)
+	require.NotSame(t, first, again)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
 )
 
 // Generates a compound ID out of a slice of strings.
@@ -122,3 +123,25 @@ func selectAccountID(providerConfig *ProviderConfig, d *schema.ResourceData) int
 
 	return providerConfig.AccountID
 }
+
+// resourceAPIKeySchema is the schema of the `api_key` attribute of resources
+// that can be managed with an API key other than the provider's.
+func resourceAPIKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "The Personal API key used to manage this resource instead of the provider's.",
+	}
+}
+
+// Selects the client for usage within a resource. An `api_key` provided within
+// the `resource` block takes precedence, followed by the provider's
+// `account_credentials` for the resource's account ID.
+func selectClient(providerConfig *ProviderConfig, d *schema.ResourceData) (*nr.NewRelic, error) {
+	if apiKey, ok := d.GetOk("api_key"); ok {
+		return providerConfig.clientForAPIKey(apiKey.(string))
+	}
+
+	return providerConfig.clientForAccount(selectAccountID(providerConfig, d))
+}
@@ -26,6 +26,7 @@ func resourceNewRelicAwsGovCloudLinkAccount() *schema.Resource {
 				Computed:    true,
 				Description: "The ID of the account in New Relic.",
 			},
+			"api_key": resourceAPIKeySchema(),
 			"access_key_id": {
 				Type:        schema.TypeString,
 				Description: "access-key-id of awsGovcloud account",
@@ -59,7 +60,10 @@ func resourceNewRelicAwsGovCloudLinkAccount() *schema.Resource {
 
 func resourceNewRelicAwsGovCloudLinkAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	accountID := selectAccountID(providerConfig, d)
 
@@ -111,7 +115,10 @@ func expandAwsGovCloudLinkAccountInput(d *schema.ResourceData) cloud.CloudLinkCl
 }
 func resourceNewRelicAwsGovCloudLinkAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	accountID := selectAccountID(providerConfig, d)
 	linkedAccountID, convErr := strconv.Atoi(d.Id())
 
@@ -119,7 +126,7 @@ func resourceNewRelicAwsGovCloudLinkAccountRead(ctx context.Context, d *schema.R
 		return diag.FromErr(convErr)
 	}
 
-	linkedAccountPayload, err := client.Cloud.GetLinkedAccount(accountID, linkedAccountID)
+	linkedAccountPayload, err := client.Cloud.GetLinkedAccountWithContext(ctx, accountID, linkedAccountID)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -137,7 +144,10 @@ func readAwsGovCloudLinkAccount(d *schema.ResourceData, result *cloud.CloudLinke
 
 func resourceNewRelicAwsGovCloudLinkAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	accountID := selectAccountID(providerConfig, d)
 	id, _ := strconv.Atoi(d.Id())
 	input := []cloud.CloudRenameAccountsInput{
@@ -146,9 +156,9 @@ func resourceNewRelicAwsGovCloudLinkAccountUpdate(ctx context.Context, d *schema
 			LinkedAccountId: id,
 		},
 	}
-	cloudRenameAccountPayload, err := client.Cloud.CloudRenameAccount(accountID, input)
+	cloudRenameAccountPayload, err := client.Cloud.CloudRenameAccountWithContext(ctx, accountID, input)
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 	var diags diag.Diagnostics
 
@@ -167,7 +177,10 @@ func resourceNewRelicAwsGovCloudLinkAccountUpdate(ctx context.Context, d *schema
 
 func resourceNewRelicAwsGovCloudLinkAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	accountID := selectAccountID(providerConfig, d)
 
 	linkedAccountID, convErr := strconv.Atoi(d.Id())
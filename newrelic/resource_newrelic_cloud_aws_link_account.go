@@ -31,6 +31,7 @@ func resourceNewRelicCloudAwsAccountLinkAccount() *schema.Resource {
 				Computed:    true,
 				Description: "The New Relic account ID where you want to link the AWS account.",
 			},
+			"api_key": resourceAPIKeySchema(),
 			"arn": {
 				Type:        schema.TypeString,
 				Description: "The AWS role ARN.",
@@ -56,7 +57,10 @@ func resourceNewRelicCloudAwsAccountLinkAccount() *schema.Resource {
 
 func resourceNewRelicCloudAwsAccountLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	accountID := selectAccountID(providerConfig, d)
 
@@ -123,7 +127,10 @@ func expandAwsCloudLinkAccountInput(d *schema.ResourceData) cloud.CloudLinkCloud
 
 func resourceNewRelicCloudAwsAccountLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	accountID := selectAccountID(providerConfig, d)
 
@@ -153,7 +160,10 @@ func readAwsLinkedAccount(d *schema.ResourceData, result *cloud.CloudLinkedAccou
 
 func resourceNewRelicCloudAwsAccountLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	accountID := selectAccountID(providerConfig, d)
 	id, _ := strconv.Atoi(d.Id())
 	input := []cloud.CloudRenameAccountsInput{
@@ -184,7 +194,10 @@ func resourceNewRelicCloudAwsAccountLinkUpdate(ctx context.Context, d *schema.Re
 
 func resourceNewRelicCloudAwsAccountLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	accountID := selectAccountID(providerConfig, d)
 
 	linkedAccountID, convErr := strconv.Atoi(d.Id())
@@ -25,6 +25,7 @@ func resourceNewRelicCloudAzureLinkAccount() *schema.Resource {
 				Computed:    true,
 				Description: "The New Relic account ID where you want to link the Azure account.",
 			},
+			"api_key": resourceAPIKeySchema(),
 			"application_id": {
 				Type:        schema.TypeString,
 				Description: "Application ID for Azure account",
@@ -57,7 +58,10 @@ func resourceNewRelicCloudAzureLinkAccount() *schema.Resource {
 
 func resourceNewRelicCloudAzureLinkAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	accountID := selectAccountID(providerConfig, d)
 	linkAccountInput := expandAzureCloudLinkAccountInput(d)
 	var diags diag.Diagnostics
@@ -115,7 +119,10 @@ func expandAzureCloudLinkAccountInput(d *schema.ResourceData) cloud.CloudLinkClo
 
 func resourceNewRelicCloudAzureLinkAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	accountID := selectAccountID(providerConfig, d)
 	linkedAccountID, convErr := strconv.Atoi(d.Id())
 
@@ -141,7 +148,10 @@ func readAzureLinkedAccount(d *schema.ResourceData, result *cloud.CloudLinkedAcc
 
 func resourceNewRelicCloudAzureLinkAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	accountID := selectAccountID(providerConfig, d)
 	id, _ := strconv.Atoi(d.Id())
 	input := []cloud.CloudRenameAccountsInput{
@@ -175,7 +185,10 @@ func resourceNewRelicCloudAzureLinkAccountUpdate(ctx context.Context, d *schema.
 
 func resourceNewRelicCloudAzureLinkAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	accountID := selectAccountID(providerConfig, d)
 
 	linkedAccountID, convErr := strconv.Atoi(d.Id())
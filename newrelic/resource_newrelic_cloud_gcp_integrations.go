@@ -2,6 +2,7 @@ package newrelic
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,7 +17,7 @@ func resourceNewrelicCloudGcpIntegrations() *schema.Resource {
 		UpdateContext: resourceNewrelicCloudGcpIntegrationsUpdate,
 		DeleteContext: resourceNewrelicCloudGcpIntegrationsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceNewrelicCloudGcpIntegrationsImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -1164,12 +1165,33 @@ func resourceNewrelicCloudGcpIntegrationsRead(ctx context.Context, d *schema.Res
 
 	linkedAccount, err := client.Cloud.GetLinkedAccountWithContext(ctx, accountID, linkedAccountID)
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 	flattenCloudGcpLinkedAccount(d, linkedAccount)
 	return nil
 }
 
+// resourceNewrelicCloudGcpIntegrationsImport imports the integrations of an
+// existing linked GCP account. The import ID is the linked_account_id; every
+// integration currently enabled on the account is read back into its block.
+func resourceNewrelicCloudGcpIntegrationsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	linkedAccountID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid import ID %q: expected the numeric linked_account_id", d.Id())
+	}
+
+	if err := d.Set("linked_account_id", linkedAccountID); err != nil {
+		return nil, err
+	}
+
+	diags := resourceNewrelicCloudGcpIntegrationsRead(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error reading GCP integrations for linked account %d: %s", linkedAccountID, diags[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 //flatten function to set(store) outputs from the terraform apply
 // TODO: Reduce the cyclomatic complexity of this func
 // nolint:gocyclo
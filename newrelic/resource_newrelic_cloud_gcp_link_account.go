@@ -2,9 +2,14 @@ package newrelic
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/newrelic/newrelic-client-go/pkg/cloud"
 )
@@ -25,6 +30,7 @@ func resourceNewRelicCloudGcpLinkAccount() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"api_key": resourceAPIKeySchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Description: "name of the linked account",
@@ -36,42 +42,117 @@ func resourceNewRelicCloudGcpLinkAccount() *schema.Resource {
 				Required:    true,
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+		},
 	}
 }
 
 func resourceNewRelicCloudGcpLinkAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
 	accountID := selectAccountID(providerConfig, d)
 
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	linkAccountInput := expandGcpCloudLinkAccountInput(d)
 
 	var diags diag.Diagnostics
+	var quotaErr *cloud.CloudAccountMutationError
 
-	//cloudLinkAccountWithContext func which links Gcp account with Newrelic
-	//which returns payload and error
-	cloudLinkAccountPayload, err := client.Cloud.CloudLinkAccountWithContext(ctx, accountID, linkAccountInput)
+	retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		diags = nil
+		quotaErr = nil
 
-	if err != nil {
-		diag.FromErr(err)
-	}
+		//cloudLinkAccountWithContext func which links Gcp account with Newrelic
+		//which returns payload and error
+		cloudLinkAccountPayload, err := client.Cloud.CloudLinkAccountWithContext(ctx, accountID, linkAccountInput)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-	if len(cloudLinkAccountPayload.Errors) > 0 {
-		for _, err := range cloudLinkAccountPayload.Errors {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  err.Type + " " + err.Message,
-			})
+		if len(cloudLinkAccountPayload.Errors) > 0 {
+			for i, err := range cloudLinkAccountPayload.Errors {
+				// Quota errors usually clear up once the quota window resets.
+				if gcpLinkAccountErrorKind(err) == gcpLinkAccountErrorQuotaExceeded {
+					quotaErr = &cloudLinkAccountPayload.Errors[i]
+					return resource.RetryableError(fmt.Errorf("%s : %s", err.Type, err.Message))
+				}
+
+				diags = append(diags, gcpLinkAccountErrorDiagnostic(err))
+			}
+		}
+
+		if len(cloudLinkAccountPayload.LinkedAccounts) > 0 {
+			d.SetId(strconv.Itoa(cloudLinkAccountPayload.LinkedAccounts[0].ID))
 		}
-	}
 
-	if len(cloudLinkAccountPayload.LinkedAccounts) > 0 {
-		d.SetId(strconv.Itoa(cloudLinkAccountPayload.LinkedAccounts[0].ID))
+		return nil
+	})
+
+	if retryErr != nil {
+		if quotaErr != nil {
+			return diag.Diagnostics{gcpLinkAccountErrorDiagnostic(*quotaErr)}
+		}
+
+		return diag.FromErr(retryErr)
 	}
 
 	return diags
 }
 
+const (
+	gcpLinkAccountErrorInsufficientPermissions = "insufficient_permissions"
+	gcpLinkAccountErrorProjectNotFound         = "project_not_found"
+	gcpLinkAccountErrorQuotaExceeded           = "quota_exceeded"
+)
+
+// gcpLinkAccountErrorKind classifies the Cloud API errors New Relic passes
+// through when linking a GCP project.
+func gcpLinkAccountErrorKind(err cloud.CloudAccountMutationError) string {
+	text := strings.ToLower(err.Type + " " + err.Message)
+
+	switch {
+	case strings.Contains(text, "permission") || strings.Contains(text, "forbidden"):
+		return gcpLinkAccountErrorInsufficientPermissions
+	case strings.Contains(text, "not_found") || strings.Contains(text, "not found"):
+		return gcpLinkAccountErrorProjectNotFound
+	case strings.Contains(text, "resource_exhausted") || strings.Contains(text, "quota"):
+		return gcpLinkAccountErrorQuotaExceeded
+	}
+
+	return ""
+}
+
+// gcpLinkAccountErrorDiagnostic returns a diagnostic for a link account error,
+// pointing at project_id with a hint when the cause is recognized.
+func gcpLinkAccountErrorDiagnostic(err cloud.CloudAccountMutationError) diag.Diagnostic {
+	detail := ""
+
+	switch gcpLinkAccountErrorKind(err) {
+	case gcpLinkAccountErrorInsufficientPermissions:
+		detail = "The New Relic service account does not have access to the GCP project. Grant it the Project Viewer and Service Usage Consumer roles on the project and try again."
+	case gcpLinkAccountErrorProjectNotFound:
+		detail = "The GCP project could not be found. Check that project_id is the project ID (not its name or number) and that the project has not been deleted."
+	case gcpLinkAccountErrorQuotaExceeded:
+		detail = "A Cloud API quota of the GCP project was exceeded while linking it. Wait for the quota to reset or request a higher quota for the project, then try again."
+	default:
+		return diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Type + " " + err.Message,
+		}
+	}
+
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       err.Type + " " + err.Message,
+		Detail:        detail,
+		AttributePath: cty.GetAttrPath("project_id"),
+	}
+}
+
 //expand function to extract inputs from the schema.
 //Here it takes ResourceData as input and returns cloudLinkCloudAccountsInput.
 func expandGcpCloudLinkAccountInput(d *schema.ResourceData) cloud.CloudLinkCloudAccountsInput {
@@ -96,9 +177,13 @@ func expandGcpCloudLinkAccountInput(d *schema.ResourceData) cloud.CloudLinkCloud
 
 func resourceNewRelicCloudGcpLinkAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
 	accountID := selectAccountID(providerConfig, d)
 
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	linkedAccountID, convErr := strconv.Atoi(d.Id())
 
 	if convErr != nil {
@@ -127,9 +212,13 @@ func readGcpLinkedAccount(d *schema.ResourceData, result *cloud.CloudLinkedAccou
 
 func resourceNewRelicCloudGcpLinkAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
 	accountID := selectAccountID(providerConfig, d)
 
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	id, convErr := strconv.Atoi(d.Id())
 
 	if convErr != nil {
@@ -171,9 +260,13 @@ func resourceNewRelicCloudGcpLinkAccountUpdate(ctx context.Context, d *schema.Re
 
 func resourceNewRelicCloudGcpLinkAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
-	client := providerConfig.NewClient
 	accountID := selectAccountID(providerConfig, d)
 
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	linkedAccountID, convErr := strconv.Atoi(d.Id())
 
 	if convErr != nil {
@@ -0,0 +1,94 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func testGcpLinkAccountResponse(errorType string, message string) string {
+	if errorType == "" {
+		return `{"data":{"cloudLinkAccount":{"errors":[],"linkedAccounts":[{"id":456,"name":"foo"}]}}}`
+	}
+
+	return fmt.Sprintf(`{"data":{"cloudLinkAccount":{"errors":[{"type":%q,"message":%q}],"linkedAccounts":[]}}}`, errorType, message)
+}
+
+func TestResourceNewRelicCloudGcpLinkAccountCreate_Errors(t *testing.T) {
+	cases := map[string]struct {
+		errorType string
+		message   string
+		detail    string
+	}{
+		"insufficient permissions": {
+			errorType: "PERMISSION_DENIED",
+			message:   "The caller does not have permission",
+			detail:    "does not have access to the GCP project",
+		},
+		"project not found": {
+			errorType: "NOT_FOUND",
+			message:   "Project 'foo' not found",
+			detail:    "could not be found",
+		},
+		"quota exceeded": {
+			errorType: "RESOURCE_EXHAUSTED",
+			message:   "Quota exceeded for quota metric 'Read requests'",
+			detail:    "quota of the GCP project was exceeded",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(testGcpLinkAccountResponse(c.errorType, c.message)))
+			})
+
+			// Keep the quota retries short.
+			r := resourceNewRelicCloudGcpLinkAccount()
+			r.Timeouts = &schema.ResourceTimeout{Create: schema.DefaultTimeout(time.Second)}
+
+			d := r.Data(nil)
+			require.NoError(t, d.Set("name", "foo"))
+			require.NoError(t, d.Set("project_id", "foo"))
+
+			diags := resourceNewRelicCloudGcpLinkAccountCreate(context.Background(), d, providerConfig)
+			require.True(t, diags.HasError())
+			require.Len(t, diags, 1)
+			require.Contains(t, diags[0].Detail, c.detail)
+			require.Equal(t, cty.GetAttrPath("project_id"), diags[0].AttributePath)
+			require.Empty(t, d.Id())
+		})
+	}
+}
+
+func TestResourceNewRelicCloudGcpLinkAccountCreate_QuotaRetry(t *testing.T) {
+	requests := 0
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			_, _ = w.Write([]byte(testGcpLinkAccountResponse("RESOURCE_EXHAUSTED", "Quota exceeded")))
+			return
+		}
+
+		_, _ = w.Write([]byte(testGcpLinkAccountResponse("", "")))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicCloudGcpLinkAccount().Schema, map[string]interface{}{
+		"name":       "foo",
+		"project_id": "foo",
+	})
+
+	diags := resourceNewRelicCloudGcpLinkAccountCreate(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, 2, requests)
+	require.Equal(t, "456", d.Id())
+}
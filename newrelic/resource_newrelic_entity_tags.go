@@ -5,11 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
 	"github.com/newrelic/newrelic-client-go/pkg/common"
 	"github.com/newrelic/newrelic-client-go/pkg/entities"
 	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
@@ -321,3 +324,207 @@ func getTag(tags []*entities.TaggingTagInput, key string) *entities.TaggingTagIn
 
 	return nil
 }
+
+// entityTagsSchema returns the optional `tag` block used by resources that
+// manage the tags of their own entity inline.
+func entityTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "A set of key-value pairs to represent a tag. For example: Team:TeamName",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The tag key.",
+				},
+				"values": {
+					Type:        schema.TypeSet,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					MinItems:    1,
+					Required:    true,
+					Description: "The tag values.",
+				},
+			},
+		},
+	}
+}
+
+// entityTaggingError wraps the expected errors returned in the payload of a
+// tagging mutation.
+type entityTaggingError struct {
+	errors []entities.TaggingMutationError
+}
+
+func (e *entityTaggingError) Error() string {
+	messages := make([]string, len(e.errors))
+	for i, err := range e.errors {
+		messages[i] = fmt.Sprintf("%s: %s", err.Type, err.Message)
+	}
+
+	return fmt.Sprintf("error tagging entity: %s", strings.Join(messages, ", "))
+}
+
+func taggingMutationResultError(result *entities.TaggingMutationResult) error {
+	if result == nil {
+		return nil
+	}
+
+	var errs []entities.TaggingMutationError
+	for _, e := range result.Errors {
+		// Delayed updates are informational and do not indicate a failure.
+		if e.Type == entities.TaggingMutationErrorTypeTypes.UPDATE_WILL_BE_DELAYED {
+			continue
+		}
+
+		errs = append(errs, e)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &entityTaggingError{errors: errs}
+}
+
+// entityTaggingPartialError reports the tags that could not be added to an
+// entity while the others were.
+type entityTaggingPartialError struct {
+	applied []string
+	failed  map[string]error
+}
+
+func (e *entityTaggingPartialError) Error() string {
+	keys := make([]string, 0, len(e.failed))
+	for k := range e.failed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	messages := make([]string, len(keys))
+	for i, k := range keys {
+		messages[i] = fmt.Sprintf("%s (%s)", k, e.failed[k])
+	}
+
+	return fmt.Sprintf("%d of %d tags could not be added: %s", len(keys), len(keys)+len(e.applied), strings.Join(messages, ", "))
+}
+
+// addEntityTags adds the tags to an entity in a single mutation. When the
+// mutation fails for a reason other than a missing permission, the tags are
+// added one by one so that a tag the API rejects doesn't hold back the
+// others. An *entityTaggingPartialError is returned when only some of them
+// could be added.
+func addEntityTags(ctx context.Context, client *nr.NewRelic, guid common.EntityGUID, tags []entities.TaggingTagInput) error {
+	result, err := client.Entities.TaggingAddTagsToEntityWithContext(ctx, guid, tags)
+	if err == nil {
+		err = taggingMutationResultError(result)
+	}
+
+	if err == nil || len(tags) < 2 || isEntityTaggingPermissionError(err) {
+		return err
+	}
+
+	log.Printf("[WARN] Adding %d tags to entity %s failed, adding them one by one: %s", len(tags), guid, err)
+
+	partial := &entityTaggingPartialError{failed: map[string]error{}}
+	for _, t := range tags {
+		result, tagErr := client.Entities.TaggingAddTagsToEntityWithContext(ctx, guid, []entities.TaggingTagInput{t})
+		if tagErr == nil {
+			tagErr = taggingMutationResultError(result)
+		}
+
+		if tagErr != nil {
+			partial.failed[t.Key] = tagErr
+			continue
+		}
+		partial.applied = append(partial.applied, t.Key)
+	}
+
+	switch {
+	case len(partial.failed) == 0:
+		return nil
+	case len(partial.applied) == 0:
+		return err
+	}
+
+	return partial
+}
+
+// isEntityTaggingPermissionError reports whether err indicates that the
+// configured credentials are not permitted to tag the entity.
+func isEntityTaggingPermissionError(err error) bool {
+	var tagErr *entityTaggingError
+	if errors.As(err, &tagErr) {
+		for _, e := range tagErr.errors {
+			if e.Type == entities.TaggingMutationErrorTypeTypes.NOT_PERMITTED {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return err != nil && strings.HasPrefix(err.Error(), "403 ")
+}
+
+// updateEntityTags reconciles the tags on an entity from oldTags to newTags.
+// Tag keys that were removed or whose values changed are deleted before the
+// new values are added, leaving any other tags on the entity untouched.
+func updateEntityTags(ctx context.Context, client *nr.NewRelic, guid common.EntityGUID, oldTags []entities.TaggingTagInput, newTags []entities.TaggingTagInput) error {
+	var deleteKeys []string
+	var addTags []entities.TaggingTagInput
+
+	for _, o := range oldTags {
+		if n := findTaggingTagInput(newTags, o.Key); n == nil || !sameTagValues(o.Values, n.Values) {
+			deleteKeys = append(deleteKeys, o.Key)
+		}
+	}
+
+	for _, n := range newTags {
+		if o := findTaggingTagInput(oldTags, n.Key); o == nil || !sameTagValues(o.Values, n.Values) {
+			addTags = append(addTags, n)
+		}
+	}
+
+	if len(deleteKeys) > 0 {
+		result, err := client.Entities.TaggingDeleteTagFromEntityWithContext(ctx, guid, deleteKeys)
+		if err != nil {
+			return err
+		}
+
+		if err := taggingMutationResultError(result); err != nil {
+			return err
+		}
+	}
+
+	if len(addTags) > 0 {
+		return addEntityTags(ctx, client, guid, addTags)
+	}
+
+	return nil
+}
+
+func findTaggingTagInput(tags []entities.TaggingTagInput, key string) *entities.TaggingTagInput {
+	for i := range tags {
+		if tags[i].Key == key {
+			return &tags[i]
+		}
+	}
+
+	return nil
+}
+
+func sameTagValues(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, v := range a {
+		if !stringInSlice(b, v) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,226 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+)
+
+// syntheticsBudgetAlertQuery projects the account's synthetics checks in each
+// aggregation window onto a 30-day month.
+const syntheticsBudgetAlertQuery = "SELECT rate(count(*), 30 days) FROM SyntheticCheck"
+
+// resourceNewRelicSyntheticsBudgetAlert manages a NRQL alert condition that
+// opens a violation when the account's synthetics checks, extrapolated from
+// the last lookback seconds, would exceed a monthly budget.
+func resourceNewRelicSyntheticsBudgetAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicSyntheticsBudgetAlertCreate,
+		ReadContext:   resourceNewRelicSyntheticsBudgetAlertRead,
+		UpdateContext: resourceNewRelicSyntheticsBudgetAlertUpdate,
+		DeleteContext: resourceNewRelicSyntheticsBudgetAlertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The New Relic account ID whose checks are counted.",
+			},
+			"policy_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the alert policy the condition belongs to.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Synthetics check budget",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The name of the alert condition.",
+			},
+			"threshold_checks": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of checks a month above which the projected usage opens a violation.",
+			},
+			"lookback": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.All(validation.IntBetween(300, 7200), validation.IntDivisibleBy(60)),
+				Description:  "The duration, in seconds, of recent checks the monthly usage is projected from. Must be a multiple of 60.",
+			},
+			"runbook_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The runbook URL to display in notifications.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the condition is enabled.",
+			},
+			"condition_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the NRQL alert condition.",
+			},
+		},
+	}
+}
+
+func expandSyntheticsBudgetAlertBase(d *schema.ResourceData) ([]alerts.NrqlConditionTerm, *int, *alerts.NrqlConditionAggregationMethod) {
+	threshold := float64(d.Get("threshold_checks").(int))
+	lookback := d.Get("lookback").(int)
+
+	terms := []alerts.NrqlConditionTerm{{
+		Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+		Priority:             alerts.NrqlConditionPriorities.Critical,
+		Threshold:            &threshold,
+		ThresholdDuration:    lookback,
+		ThresholdOccurrences: alerts.ThresholdOccurrences.AtLeastOnce,
+	}}
+
+	// Checks are counted over whole windows as they close, so the window is
+	// the lookback the projection is made from.
+	method := alerts.NrqlConditionAggregationMethodTypes.Cadence
+
+	return terms, &lookback, &method
+}
+
+func expandSyntheticsBudgetAlertCreateInput(d *schema.ResourceData) alerts.NrqlConditionCreateInput {
+	terms, window, method := expandSyntheticsBudgetAlertBase(d)
+	valueFunction := alerts.NrqlConditionValueFunctions.SingleValue
+
+	input := alerts.NrqlConditionCreateInput{ValueFunction: &valueFunction}
+	input.Name = d.Get("name").(string)
+	input.Enabled = d.Get("enabled").(bool)
+	input.RunbookURL = d.Get("runbook_url").(string)
+	input.Type = alerts.NrqlConditionTypes.Static
+	input.Nrql = alerts.NrqlConditionCreateQuery{Query: syntheticsBudgetAlertQuery}
+	input.Terms = terms
+	input.Signal = &alerts.AlertsNrqlConditionCreateSignal{
+		AggregationWindow: window,
+		AggregationMethod: method,
+	}
+	input.ViolationTimeLimitSeconds = syntheticsMonitorAlertViolationTimeLimitSeconds
+
+	return input
+}
+
+func expandSyntheticsBudgetAlertUpdateInput(d *schema.ResourceData) alerts.NrqlConditionUpdateInput {
+	terms, window, method := expandSyntheticsBudgetAlertBase(d)
+	valueFunction := alerts.NrqlConditionValueFunctions.SingleValue
+
+	input := alerts.NrqlConditionUpdateInput{ValueFunction: &valueFunction}
+	input.Name = d.Get("name").(string)
+	input.Enabled = d.Get("enabled").(bool)
+	input.RunbookURL = d.Get("runbook_url").(string)
+	input.Type = alerts.NrqlConditionTypes.Static
+	input.Nrql = alerts.NrqlConditionUpdateQuery{Query: syntheticsBudgetAlertQuery}
+	input.Terms = terms
+	input.Signal = &alerts.AlertsNrqlConditionUpdateSignal{
+		AggregationWindow: window,
+		AggregationMethod: method,
+	}
+	input.ViolationTimeLimitSeconds = syntheticsMonitorAlertViolationTimeLimitSeconds
+
+	return input
+}
+
+func resourceNewRelicSyntheticsBudgetAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	policyID := d.Get("policy_id").(int)
+
+	log.Printf("[INFO] Creating New Relic Synthetics budget alert in policy %d", policyID)
+
+	condition, err := client.Alerts.CreateNrqlConditionStaticMutationWithContext(ctx, accountID, strconv.Itoa(policyID), expandSyntheticsBudgetAlertCreateInput(d))
+	if err != nil {
+		return diag.Errorf("error creating synthetics budget alert condition: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d:%s", policyID, condition.ID))
+
+	return resourceNewRelicSyntheticsBudgetAlertRead(ctx, d, meta)
+}
+
+func resourceNewRelicSyntheticsBudgetAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading New Relic Synthetics budget alert %s", d.Id())
+
+	ids, err := parseIDs(d.Id(), 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	conditionID := strconv.Itoa(ids[1])
+
+	condition, err := client.Alerts.GetNrqlConditionQueryWithContext(ctx, accountID, conditionID)
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("policy_id", ids[0])
+	_ = d.Set("condition_id", conditionID)
+	_ = d.Set("name", condition.Name)
+	_ = d.Set("enabled", condition.Enabled)
+	_ = d.Set("runbook_url", condition.RunbookURL)
+
+	for _, term := range condition.Terms {
+		if term.Priority == alerts.NrqlConditionPriorities.Critical && term.Threshold != nil {
+			_ = d.Set("threshold_checks", int(*term.Threshold))
+		}
+	}
+
+	if condition.Signal != nil && condition.Signal.AggregationWindow != nil {
+		_ = d.Set("lookback", *condition.Signal.AggregationWindow)
+	}
+
+	return nil
+}
+
+func resourceNewRelicSyntheticsBudgetAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+	accountID := selectAccountID(providerConfig, d)
+	conditionID := d.Get("condition_id").(string)
+
+	log.Printf("[INFO] Updating New Relic Synthetics budget alert %s", d.Id())
+
+	if _, err := client.Alerts.UpdateNrqlConditionStaticMutationWithContext(ctx, accountID, conditionID, expandSyntheticsBudgetAlertUpdateInput(d)); err != nil {
+		return diag.Errorf("error updating synthetics budget alert condition %s: %s", conditionID, err)
+	}
+
+	return resourceNewRelicSyntheticsBudgetAlertRead(ctx, d, meta)
+}
+
+func resourceNewRelicSyntheticsBudgetAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+
+	log.Printf("[INFO] Deleting New Relic Synthetics budget alert %s", d.Id())
+
+	return diag.FromErr(deleteSyntheticsMonitorAlert(ctx, providerConfig.NewClient, selectAccountID(providerConfig, d), d.Get("condition_id").(string)))
+}
@@ -0,0 +1,31 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandSyntheticsBudgetAlertCreateInput(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsBudgetAlert().Schema, map[string]interface{}{
+		"policy_id":        1,
+		"threshold_checks": 1000000,
+		"lookback":         1800,
+	})
+
+	input := expandSyntheticsBudgetAlertCreateInput(d)
+	require.Equal(t, "Synthetics check budget", input.Name)
+	require.True(t, input.Enabled)
+	require.Equal(t, syntheticsBudgetAlertQuery, input.Nrql.Query)
+	require.Len(t, input.Terms, 1)
+	require.Equal(t, float64(1000000), *input.Terms[0].Threshold)
+	require.Equal(t, 1800, input.Terms[0].ThresholdDuration)
+	require.Equal(t, alerts.NrqlConditionPriorities.Critical, input.Terms[0].Priority)
+	require.Equal(t, 1800, *input.Signal.AggregationWindow)
+	require.Equal(t, alerts.NrqlConditionAggregationMethodTypes.Cadence, *input.Signal.AggregationMethod)
+}
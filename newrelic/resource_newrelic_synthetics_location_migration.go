@@ -0,0 +1,154 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+func resourceNewRelicSyntheticsLocationMigration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicSyntheticsLocationMigrationCreate,
+		ReadContext:   resourceNewRelicSyntheticsLocationMigrationRead,
+		DeleteContext: resourceNewRelicSyntheticsLocationMigrationDelete,
+		Schema: map[string]*schema.Schema{
+			"source_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location to move monitors off of.",
+			},
+			"target_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location to move monitors to.",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Only report the monitors that would be migrated, without updating them.",
+			},
+			"monitor_ids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The IDs of the monitors that ran in the source location when the migration was applied.",
+			},
+		},
+	}
+}
+
+func resourceNewRelicSyntheticsLocationMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+	source := d.Get("source_location").(string)
+	target := d.Get("target_location").(string)
+
+	if source == target {
+		return diag.Errorf("source_location and target_location must differ, got %q for both", source)
+	}
+
+	log.Printf("[INFO] Migrating New Relic Synthetics monitors from location %s to %s", source, target)
+
+	monitors, err := client.Synthetics.ListMonitorsWithContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	affected := syntheticsMonitorsInLocation(monitors, source)
+
+	ids := make([]string, len(affected))
+	for i, m := range affected {
+		ids[i] = m.ID
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", source, target))
+	_ = d.Set("monitor_ids", ids)
+
+	if d.Get("dry_run").(bool) {
+		log.Printf("[INFO] Dry run: %d New Relic Synthetics monitors would be migrated from %s to %s", len(affected), source, target)
+		return nil
+	}
+
+	return migrateSyntheticsMonitorsLocation(ctx, client, affected, source, target)
+}
+
+// Read leaves the recorded migration untouched; the monitors are expected to
+// change afterwards and are not tracked by this resource.
+func resourceNewRelicSyntheticsLocationMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// Delete only removes the migration from state. Migrated monitors are not
+// moved back.
+func resourceNewRelicSyntheticsLocationMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// syntheticsMonitorsInLocation returns the monitors running in the location,
+// sorted by ID.
+func syntheticsMonitorsInLocation(monitors []*synthetics.Monitor, location string) []*synthetics.Monitor {
+	var result []*synthetics.Monitor
+
+	for _, m := range monitors {
+		if stringInSlice(m.Locations, location) {
+			result = append(result, m)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+// migrateSyntheticsMonitorsLocation replaces the source location with the
+// target in each monitor, leaving the rest of the monitor unchanged. Every
+// monitor is attempted and each failure is reported separately; since
+// migrated monitors no longer run in the source location, applying again
+// only retries the failed ones.
+func migrateSyntheticsMonitorsLocation(ctx context.Context, client *nr.NewRelic, monitors []*synthetics.Monitor, source string, target string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, m := range monitors {
+		locations := []string{}
+		for _, l := range m.Locations {
+			if l != source && l != target {
+				locations = append(locations, l)
+			}
+		}
+		locations = append(locations, target)
+
+		update := synthetics.Monitor{
+			ID:           m.ID,
+			Name:         m.Name,
+			Type:         m.Type,
+			Frequency:    m.Frequency,
+			URI:          m.URI,
+			Locations:    locations,
+			Status:       m.Status,
+			SLAThreshold: m.SLAThreshold,
+			Options:      m.Options,
+		}
+
+		if _, err := client.Synthetics.UpdateMonitorWithContext(ctx, update); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("error migrating synthetics monitor %s (%s) from %s to %s", m.ID, m.Name, source, target),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,75 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	"github.com/stretchr/testify/require"
+)
+
+func testSyntheticsLocationMigrationServer(t *testing.T, updates map[string][]string) *ProviderConfig {
+	return testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/monitors":
+			_, _ = w.Write([]byte(`{"monitors":[
+				{"id":"b","name":"b","locations":["old-private","AWS_US_EAST_1"]},
+				{"id":"a","name":"a","locations":["old-private","new-private"]},
+				{"id":"c","name":"c","locations":["AWS_US_EAST_1"]},
+				{"id":"d","name":"d","locations":["old-private"]}
+			]}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v4/monitors/d":
+			w.WriteHeader(http.StatusBadRequest)
+		case r.Method == http.MethodPut:
+			var m synthetics.Monitor
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&m))
+			updates[m.ID] = m.Locations
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestResourceNewRelicSyntheticsLocationMigrationCreate(t *testing.T) {
+	updates := map[string][]string{}
+	providerConfig := testSyntheticsLocationMigrationServer(t, updates)
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsLocationMigration().Schema, map[string]interface{}{
+		"source_location": "old-private",
+		"target_location": "new-private",
+	})
+
+	diags := resourceNewRelicSyntheticsLocationMigrationCreate(context.Background(), d, providerConfig)
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Summary, "error migrating synthetics monitor d")
+
+	require.Equal(t, "old-private:new-private", d.Id())
+	require.Equal(t, []interface{}{"a", "b", "d"}, d.Get("monitor_ids"))
+	require.Equal(t, map[string][]string{
+		"a": {"new-private"},
+		"b": {"AWS_US_EAST_1", "new-private"},
+	}, updates)
+}
+
+func TestResourceNewRelicSyntheticsLocationMigrationCreate_DryRun(t *testing.T) {
+	updates := map[string][]string{}
+	providerConfig := testSyntheticsLocationMigrationServer(t, updates)
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsLocationMigration().Schema, map[string]interface{}{
+		"source_location": "old-private",
+		"target_location": "new-private",
+		"dry_run":         true,
+	})
+
+	diags := resourceNewRelicSyntheticsLocationMigrationCreate(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []interface{}{"a", "b", "d"}, d.Get("monitor_ids"))
+	require.Empty(t, updates)
+}
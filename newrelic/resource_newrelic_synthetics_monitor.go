@@ -2,38 +2,64 @@ package newrelic
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/common"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
 	"github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/nrdb"
 	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
 )
 
+var syntheticsMonitorFrequencies = []int{1, 5, 10, 15, 30, 60, 360, 720, 1440}
+
+var syntheticsMonitorTypes = []string{"SIMPLE", "BROWSER", "SCRIPT_API", "SCRIPT_BROWSER", "CERT_CHECK"}
+
+// deprecatedSyntheticsMonitorTypes maps monitor types New Relic has
+// deprecated to their replacements. Monitors of these types keep working but
+// warn at plan time. None are deprecated yet.
+var deprecatedSyntheticsMonitorTypes = map[string]string{}
+
 func resourceNewRelicSyntheticsMonitor() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNewRelicSyntheticsMonitorCreate,
 		ReadContext:   resourceNewRelicSyntheticsMonitorRead,
 		UpdateContext: resourceNewRelicSyntheticsMonitorUpdate,
 		DeleteContext: resourceNewRelicSyntheticsMonitorDelete,
+		CustomizeDiff: resourceNewRelicSyntheticsMonitorCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importSyntheticsMonitor,
 		},
 		Schema: map[string]*schema.Schema{
-			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
-				Description: "The monitor type. Valid values are SIMPLE, BROWSER, SCRIPT_BROWSER, and SCRIPT_API.",
-				ValidateFunc: validation.StringInSlice([]string{
-					"SIMPLE",
-					"BROWSER",
-					"SCRIPT_API",
-					"SCRIPT_BROWSER",
-					"CERT_CHECK",
-				}, false),
+				Description: "The New Relic account ID of the monitor. Requires matching account_credentials in the provider configuration when different from the provider's account.",
+			},
+			"api_key": resourceAPIKeySchema(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The monitor type. Valid values are SIMPLE, BROWSER, SCRIPT_BROWSER, and SCRIPT_API.",
+				ValidateFunc: stringInSliceDeprecated(syntheticsMonitorTypes, deprecatedSyntheticsMonitorTypes),
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -42,38 +68,127 @@ func resourceNewRelicSyntheticsMonitor() *schema.Resource {
 			},
 			"frequency": {
 				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: intInSlice([]int{1, 5, 10, 15, 30, 60, 360, 720, 1440}),
-				Description:  "The interval (in minutes) at which this monitor should run. Valid values are 1, 5, 10, 15, 30, 60, 360, 720, or 1440.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: intInSliceWarning(syntheticsMonitorFrequencies),
+				Description:  "The interval (in minutes) at which this monitor should run. Valid values are 1, 5, 10, 15, 30, 60, 360, 720, or 1440. Defaults to the provider's default_frequency.",
 			},
 			"uri": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The URI for the monitor to hit.",
 				// TODO: ValidateFunc (required if SIMPLE or BROWSER)
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeSyntheticsMonitorURI(old) == normalizeSyntheticsMonitorURI(new)
+				},
+			},
+			"query_parameters": {
+				Type:         schema.TypeMap,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				ValidateFunc: validateSyntheticsMonitorQueryParameters,
+				Description:  "Query parameters to append to the URI, URL-encoded by the provider. Only for SIMPLE and BROWSER monitors.",
+			},
+			"uri_candidates": {
+				Type:          schema.TypeList,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				MinItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"uri"},
+				Description:   "URIs the monitor can hit, of which primary_uri_index selects the one it does. Use instead of uri to switch URIs by index, e.g. in canary promotions.",
+			},
+			"primary_uri_index": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The index in uri_candidates of the URI the monitor hits. Read back as -1 when the monitor hits none of them.",
 			},
 			"locations": {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				MinItems:    1,
-				Required:    true,
-				Description: "The locations in which this monitor should be run.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The locations in which this monitor should be run. Defaults to the provider's default_synthetics_locations.",
+			},
+			"location_group": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"locations"},
+				Description:   "The name of a synthetics_location_group of the provider configuration to take the monitor's locations from.",
+			},
+			"locations_by_workspace": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Locations used instead of `locations` when the provider's workspace matches.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"workspace": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Description:  "The name of the Terraform workspace.",
+						},
+						"locations": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Required:    true,
+							MinItems:    1,
+							Description: "The locations in which this monitor should be run in the workspace.",
+						},
+					},
+				},
+			},
+			"ignore_external_locations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep locations added to the monitor outside of Terraform instead of removing them. They are exported as external_locations.",
+			},
+			"external_locations": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The locations added to the monitor outside of Terraform. Only populated when ignore_external_locations is true.",
 			},
 			"status": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The monitor status (i.e. ENABLED, MUTED, DISABLED).",
+				Optional:    true,
+				Computed:    true,
+				Description: "The monitor status (i.e. ENABLED, MUTED, DISABLED). Defaults to the provider's default_status.",
 				ValidateFunc: validation.StringInSlice([]string{
 					"ENABLED",
 					"MUTED",
 					"DISABLED",
 				}, false),
 			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Adopt an existing monitor with this name without modifying it. Create, update and delete only read the monitor, and changes to the configuration are reported as warnings instead of being applied.",
+			},
+			"verify_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the first check of a new monitor and fail the apply if it fails. The monitor must be ENABLED or MUTED.",
+			},
+			"skip_status_wait": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip waiting for the monitor to report the new status after a status change.",
+			},
 			"sla_threshold": {
 				Type:        schema.TypeFloat,
 				Optional:    true,
-				Default:     7,
-				Description: "The base threshold (in seconds) to calculate the apdex score for use in the SLA report. (Default 7 seconds)",
+				Computed:    true,
+				Description: "The base threshold (in seconds) to calculate the apdex score for use in the SLA report. Defaults to the provider's default_sla_threshold, or 7 seconds.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return syntheticsMonitorSLAThresholdsEqual(old, new)
+				},
 			},
 			// TODO: ValidationFunc (options only valid if SIMPLE or BROWSER)
 			"validation_string": {
@@ -81,173 +196,1855 @@ func resourceNewRelicSyntheticsMonitor() *schema.Resource {
 				Optional:    true,
 				Description: "The string to validate against in the response.",
 			},
-			"verify_ssl": {
-				Type:        schema.TypeBool,
+			"validation_string_secret": {
+				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Verify SSL.",
+				Sensitive:   true,
+				Description: "The string to validate against in the response, redacted from plan output. Takes precedence over validation_string when set.",
+			},
+			"verify_ssl": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Description:   "Verify SSL.",
+				Deprecated:    "use the ssl block's verify_certificate attribute instead",
+				ConflictsWith: []string{"ssl"},
+			},
+			"ssl": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "SSL/TLS settings for SIMPLE and BROWSER monitors.",
+				ConflictsWith: []string{"verify_ssl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"verify_certificate": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Validate the certificate chain and host name of the monitored endpoint.",
+						},
+					},
+				},
+			},
+			"allow_self_signed_until": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "An RFC 3339 timestamp until which certificate validation is disabled, e.g. while an endpoint still uses a self-signed certificate. Once it has passed, the next apply enables the configured certificate validation.",
 			},
 			"bypass_head_request": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Bypass HEAD request.",
+				Computed:    true,
+				Description: "Bypass HEAD request. Defaults to true when a validation string is set, unless the provider's strict_synthetics_monitor_options is enabled.",
 			},
 			"treat_redirect_as_failure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Fail the monitor check if redirected.",
 			},
+			"tag": entityTagsSchema(),
+			"provider_tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The provider's default_tags applied to the monitor, excluding keys set through tag blocks.",
+			},
+			"require_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail when the monitor's tags cannot be applied because the API key lacks entity tagging permissions, or when the API rejects some of them. When false, a warning is emitted and the monitor is kept.",
+			},
+			"config_checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A checksum of the monitor's configuration as returned by the API. Changes whenever the monitor is modified, including out-of-band.",
+			},
+			"fetch_alert_conditions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Look up the synthetics alert conditions that reference this monitor and export them as alert_condition_ids. Requires listing every alert policy of the account on each read.",
+			},
+			"alert_condition_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs (<policy_id>:<condition_id>) of the synthetics and multi-location synthetics alert conditions referencing this monitor. Only populated when fetch_alert_conditions is true.",
+			},
+			"fetch_schedule": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Query the monitor's latest check and export when it will next run as next_run_at. Runs a NRQL query on each read.",
+			},
+			"next_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The estimated time (RFC 3339) of the monitor's next check: its latest check plus its frequency. Empty when the monitor is disabled, has no recent check, or fetch_schedule is false.",
+			},
+			"alert":            syntheticsMonitorAlertSchema(),
+			"location_routing": syntheticsMonitorLocationRoutingSchema(),
+			"slo":              syntheticsMonitorSLOSchema(),
+			"workload_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The GUID of a workload to add the monitor's entity to.",
+			},
+			"effective_validation_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "How the monitor checks responses given treat_redirect_as_failure and validation_string: NONE, VALIDATE_FINAL_RESPONSE, FAIL_ON_REDIRECT, or FAIL_ON_REDIRECT_THEN_VALIDATE.",
+			},
+			"api_source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API the monitor is managed through, derived from its ID: REST for Synthetics REST API monitor IDs, NERDGRAPH for monitors imported by entity GUID.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 	}
 }
 
-func buildSyntheticsMonitorStruct(d *schema.ResourceData) synthetics.Monitor {
-	monitor := synthetics.Monitor{
-		Name:         d.Get("name").(string),
-		Type:         synthetics.MonitorType(d.Get("type").(string)),
-		Frequency:    uint(d.Get("frequency").(int)),
-		Status:       synthetics.MonitorStatusType(d.Get("status").(string)),
-		SLAThreshold: d.Get("sla_threshold").(float64),
+func resourceNewRelicSyntheticsMonitorCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	providerConfig, _ := meta.(*ProviderConfig)
+
+	// Read-only monitors take their settings from the live monitor, so
+	// there are no defaults to plan or values to validate.
+	if diff.Get("read_only").(bool) {
+		return nil
 	}
 
-	if uri, ok := d.GetOk("uri"); ok {
-		monitor.URI = uri.(string)
+	if err := validateSyntheticsMonitorPrimaryURIIndex(diff); err != nil {
+		return err
 	}
 
-	locationsRaw := d.Get("locations").(*schema.Set)
-	locations := make([]string, locationsRaw.Len())
-	for i, v := range locationsRaw.List() {
-		locations[i] = fmt.Sprint(v)
+	if err := validateSyntheticsMonitorAlert(diff); err != nil {
+		return err
 	}
 
-	if validationString, ok := d.GetOk("validation_string"); ok {
-		monitor.Options.ValidationString = validationString.(string)
+	if err := validateSyntheticsMonitorLocationRouting(diff, providerConfig); err != nil {
+		return err
 	}
 
-	if verifySSL, ok := d.GetOkExists("verify_ssl"); ok {
-		monitor.Options.VerifySSL = verifySSL.(bool)
+	if err := validateSyntheticsMonitorSLO(diff); err != nil {
+		return err
 	}
 
-	if bypassHeadRequest, ok := d.GetOkExists("bypass_head_request"); ok {
-		monitor.Options.BypassHEADRequest = bypassHeadRequest.(bool)
+	if err := validateSyntheticsMonitorQueryParametersType(diff); err != nil {
+		return err
 	}
 
-	if treatRedirectAsFailure, ok := d.GetOkExists("treat_redirect_as_failure"); ok {
-		monitor.Options.TreatRedirectAsFailure = treatRedirectAsFailure.(bool)
+	if err := validateSyntheticsMonitorVerifyOnCreate(diff); err != nil {
+		return err
 	}
 
-	monitor.Locations = locations
-	return monitor
+	if err := validateSyntheticsMonitorLocationsByWorkspace(diff, providerConfig); err != nil {
+		return err
+	}
+
+	if err := setSyntheticsMonitorDefaultLocations(diff, providerConfig); err != nil {
+		return err
+	}
+
+	if err := setSyntheticsMonitorDefaultFrequencyAndStatus(diff, providerConfig); err != nil {
+		return err
+	}
+
+	if err := setSyntheticsMonitorDefaultSLAThreshold(diff, providerConfig); err != nil {
+		return err
+	}
+
+	if err := validateSyntheticsMonitorFrequency(diff); err != nil {
+		return err
+	}
+
+	if err := setSyntheticsMonitorProviderTags(diff, providerConfig); err != nil {
+		return err
+	}
+
+	// Only locations added by this change are checked, so monitors imported
+	// or created with locations missing from the snapshot keep planning
+	// cleanly until their locations are edited.
+	if providerConfig != nil && providerConfig.ValidateLocationsOffline && diff.NewValueKnown("locations") {
+		o, n := diff.GetChange("locations")
+		return validateSyntheticsLocationsOffline(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+	}
+
+	return nil
 }
 
-func buildSyntheticsUpdateMonitorArgs(d *schema.ResourceData) *synthetics.Monitor {
-	monitor := synthetics.Monitor{
-		ID:           d.Id(),
-		Name:         d.Get("name").(string),
-		Type:         synthetics.MonitorType(d.Get("type").(string)),
-		Frequency:    uint(d.Get("frequency").(int)),
-		Status:       synthetics.MonitorStatusType(d.Get("status").(string)),
-		SLAThreshold: d.Get("sla_threshold").(float64),
+// validateSyntheticsMonitorPrimaryURIIndex checks that primary_uri_index
+// selects one of the uri_candidates.
+func validateSyntheticsMonitorPrimaryURIIndex(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("uri_candidates") || !diff.NewValueKnown("primary_uri_index") {
+		return nil
 	}
 
-	if uri, ok := d.GetOk("uri"); ok {
-		monitor.URI = uri.(string)
+	candidates := diff.Get("uri_candidates").([]interface{})
+	if len(candidates) == 0 {
+		return nil
 	}
 
-	locationsRaw := d.Get("locations").(*schema.Set)
-	locations := make([]string, locationsRaw.Len())
-	for i, v := range locationsRaw.List() {
-		locations[i] = fmt.Sprint(v)
+	if index := diff.Get("primary_uri_index").(int); index >= len(candidates) {
+		return fmt.Errorf("primary_uri_index %d is out of range: uri_candidates has %d URIs", index, len(candidates))
 	}
 
-	if validationString, ok := d.GetOk("validation_string"); ok {
-		monitor.Options.ValidationString = validationString.(string)
+	return nil
+}
+
+func validateSyntheticsMonitorQueryParameters(i interface{}, k string) (ws []string, es []error) {
+	for key := range i.(map[string]interface{}) {
+		if strings.TrimSpace(key) == "" {
+			es = append(es, fmt.Errorf("%s keys must not be empty", k))
+		}
 	}
 
-	if verifySSL, ok := d.GetOkExists("verify_ssl"); ok {
-		monitor.Options.VerifySSL = verifySSL.(bool)
+	return
+}
+
+// validateSyntheticsMonitorQueryParametersType checks that query parameters
+// are only set on the monitor types that hit a URI.
+func validateSyntheticsMonitorQueryParametersType(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("type") || len(diff.Get("query_parameters").(map[string]interface{})) == 0 {
+		return nil
 	}
 
-	if bypassHeadRequest, ok := d.GetOkExists("bypass_head_request"); ok {
-		monitor.Options.BypassHEADRequest = bypassHeadRequest.(bool)
+	monitorType := diff.Get("type").(string)
+	if monitorType != string(synthetics.MonitorTypes.Ping) && monitorType != string(synthetics.MonitorTypes.Browser) {
+		return fmt.Errorf("query_parameters can only be set on SIMPLE and BROWSER monitors, not %s", monitorType)
 	}
 
-	if treatRedirectAsFailure, ok := d.GetOkExists("treat_redirect_as_failure"); ok {
-		monitor.Options.TreatRedirectAsFailure = treatRedirectAsFailure.(bool)
+	return nil
+}
+
+// validateSyntheticsMonitorVerifyOnCreate rejects verify_on_create for
+// monitors created DISABLED, whose first check would never run.
+func validateSyntheticsMonitorVerifyOnCreate(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" || !diff.Get("verify_on_create").(bool) || !diff.NewValueKnown("status") {
+		return nil
 	}
 
-	monitor.Locations = locations
-	return &monitor
+	if diff.Get("status").(string) == string(synthetics.MonitorStatus.Disabled) {
+		return fmt.Errorf("verify_on_create requires an ENABLED or MUTED monitor, since a DISABLED monitor doesn't run checks")
+	}
+
+	return nil
 }
 
-func readSyntheticsMonitorStruct(monitor *synthetics.Monitor, d *schema.ResourceData) {
-	_ = d.Set("name", monitor.Name)
-	_ = d.Set("type", monitor.Type)
-	_ = d.Set("frequency", monitor.Frequency)
-	_ = d.Set("uri", monitor.URI)
-	_ = d.Set("locations", monitor.Locations)
-	_ = d.Set("status", monitor.Status)
-	_ = d.Set("sla_threshold", monitor.SLAThreshold)
-	_ = d.Set("verify_ssl", monitor.Options.VerifySSL)
-	_ = d.Set("validation_string", monitor.Options.ValidationString)
-	_ = d.Set("bypass_head_request", monitor.Options.BypassHEADRequest)
-	_ = d.Set("treat_redirect_as_failure", monitor.Options.TreatRedirectAsFailure)
+// validateSyntheticsMonitorLocationsByWorkspace checks that each workspace
+// has a single locations_by_workspace block and, with
+// validate_locations_offline, that each block's locations are known, whether
+// or not its workspace is the current one.
+func validateSyntheticsMonitorLocationsByWorkspace(diff *schema.ResourceDiff, providerConfig *ProviderConfig) error {
+	if !diff.NewValueKnown("locations_by_workspace") {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	for _, b := range diff.Get("locations_by_workspace").([]interface{}) {
+		if b == nil {
+			continue
+		}
+		block := b.(map[string]interface{})
+
+		workspace := block["workspace"].(string)
+		if seen[workspace] {
+			return fmt.Errorf("locations_by_workspace: workspace %q has more than one block", workspace)
+		}
+		seen[workspace] = true
+
+		if providerConfig != nil && providerConfig.ValidateLocationsOffline {
+			if err := validateSyntheticsLocationsOffline(block["locations"].(*schema.Set).List()); err != nil {
+				return fmt.Errorf("locations_by_workspace %q: %w", workspace, err)
+			}
+		}
+	}
+
+	return nil
 }
 
-func resourceNewRelicSyntheticsMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ProviderConfig).NewClient
-	monitorStruct := buildSyntheticsMonitorStruct(d)
+// syntheticsMonitorWorkspaceLocations returns the locations of the
+// locations_by_workspace block of the provider's workspace, if any.
+func syntheticsMonitorWorkspaceLocations(diff *schema.ResourceDiff, providerConfig *ProviderConfig) ([]interface{}, bool) {
+	if providerConfig == nil || providerConfig.Workspace == "" || !diff.NewValueKnown("locations_by_workspace") {
+		return nil, false
+	}
 
-	log.Printf("[INFO] Creating New Relic Synthetics monitor %s", monitorStruct.Name)
+	for _, b := range diff.Get("locations_by_workspace").([]interface{}) {
+		if b == nil {
+			continue
+		}
+		block := b.(map[string]interface{})
 
-	monitor, err := client.Synthetics.CreateMonitorWithContext(ctx, monitorStruct)
-	if err != nil {
-		return diag.FromErr(err)
+		if block["workspace"].(string) == providerConfig.Workspace {
+			return block["locations"].(*schema.Set).List(), true
+		}
 	}
 
-	d.SetId(monitor.ID)
-	return resourceNewRelicSyntheticsMonitorRead(ctx, d, meta)
+	return nil, false
 }
 
-func resourceNewRelicSyntheticsMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ProviderConfig).NewClient
+// setSyntheticsMonitorDefaultLocations plans the locations of the
+// locations_by_workspace block matching the provider's workspace. Failing
+// that, it plans the locations of the monitor's location_group, or the
+// provider's default locations, for monitors whose configuration omits
+// `locations`.
+func setSyntheticsMonitorDefaultLocations(diff *schema.ResourceDiff, providerConfig *ProviderConfig) error {
+	if locations, ok := syntheticsMonitorWorkspaceLocations(diff, providerConfig); ok {
+		return diff.SetNew("locations", locations)
+	}
 
-	log.Printf("[INFO] Reading New Relic Synthetics monitor %s", d.Id())
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr("locations").IsNull() {
+		return nil
+	}
 
-	monitor, err := client.Synthetics.GetMonitorWithContext(ctx, d.Id())
-	if err != nil {
-		if _, ok := err.(*errors.NotFound); ok {
-			d.SetId("")
+	if group := diff.Get("location_group").(string); group != "" {
+		locations, err := syntheticsLocationGroupLocations(providerConfig, group)
+		if err != nil {
+			return err
+		}
+
+		return diff.SetNew("locations", locations)
+	}
+
+	if providerConfig == nil || len(providerConfig.DefaultSyntheticsLocations) == 0 {
+		return fmt.Errorf("locations must be set on the monitor or through default_synthetics_locations in the provider configuration")
+	}
+
+	return diff.SetNew("locations", providerConfig.DefaultSyntheticsLocations)
+}
+
+// setSyntheticsMonitorDefaultFrequencyAndStatus plans the provider's
+// default_frequency and default_status for monitors whose configuration omits
+// them.
+func setSyntheticsMonitorDefaultFrequencyAndStatus(diff *schema.ResourceDiff, providerConfig *ProviderConfig) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	if rawConfig.GetAttr("frequency").IsNull() {
+		if providerConfig == nil || providerConfig.DefaultSyntheticsFrequency == 0 {
+			return fmt.Errorf("frequency must be set on the monitor or through default_frequency in the provider configuration")
+		}
+
+		if err := diff.SetNew("frequency", providerConfig.DefaultSyntheticsFrequency); err != nil {
+			return err
+		}
+	}
+
+	if rawConfig.GetAttr("status").IsNull() {
+		if providerConfig == nil || providerConfig.DefaultSyntheticsStatus == "" {
+			return fmt.Errorf("status must be set on the monitor or through default_status in the provider configuration")
+		}
+
+		if err := diff.SetNew("status", providerConfig.DefaultSyntheticsStatus); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syntheticsMonitorDefaultSLAThreshold is the SLA threshold, in seconds, of
+// monitors that don't set one when the provider has no default_sla_threshold.
+const syntheticsMonitorDefaultSLAThreshold = 7
+
+// setSyntheticsMonitorDefaultSLAThreshold plans the provider's
+// default_sla_threshold, or syntheticsMonitorDefaultSLAThreshold, for monitors
+// whose configuration omits `sla_threshold`.
+func setSyntheticsMonitorDefaultSLAThreshold(diff *schema.ResourceDiff, providerConfig *ProviderConfig) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr("sla_threshold").IsNull() {
+		return nil
+	}
+
+	threshold := float64(syntheticsMonitorDefaultSLAThreshold)
+	if providerConfig != nil && providerConfig.DefaultSyntheticsSLAThreshold > 0 {
+		threshold = providerConfig.DefaultSyntheticsSLAThreshold
+	}
+
+	return diff.SetNew("sla_threshold", threshold)
+}
+
+// setSyntheticsMonitorProviderTags plans the provider's default tags that apply
+// to the monitor. Keys set through `tag` blocks take precedence.
+func setSyntheticsMonitorProviderTags(diff *schema.ResourceDiff, providerConfig *ProviderConfig) error {
+	providerTags := map[string]interface{}{}
+
+	if providerConfig != nil {
+		configured := map[string]bool{}
+		for _, t := range diff.Get("tag").(*schema.Set).List() {
+			configured[t.(map[string]interface{})["key"].(string)] = true
+		}
+
+		for k, v := range providerConfig.DefaultTags {
+			if !configured[k] {
+				providerTags[k] = v
+			}
+		}
+	}
+
+	if len(providerTags) == 0 && len(diff.Get("provider_tags").(map[string]interface{})) == 0 {
+		return nil
+	}
+
+	return diff.SetNew("provider_tags", providerTags)
+}
+
+// validateSyntheticsMonitorFrequency rejects unsupported frequencies when they
+// are newly configured. A monitor that already runs at a frequency the API has
+// since withdrawn (or that was imported with one) only gets a warning from the
+// schema validation, so its plans keep working.
+func validateSyntheticsMonitorFrequency(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" && !diff.HasChange("frequency") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("frequency") {
+		return nil
+	}
+
+	frequency := diff.Get("frequency").(int)
+	for _, f := range syntheticsMonitorFrequencies {
+		if frequency == f {
 			return nil
 		}
+	}
 
-		return diag.FromErr(err)
+	return fmt.Errorf("expected frequency to be one of %v, got %d", syntheticsMonitorFrequencies, frequency)
+}
+
+// validateSyntheticsLocationsOffline checks locations against the embedded
+// snapshot of public locations. Private locations don't follow the upper case
+// naming of public ones and are not checked.
+func validateSyntheticsLocationsOffline(locations []interface{}) error {
+	var unknown []string
+
+	for _, l := range locations {
+		location := l.(string)
+		if location != strings.ToUpper(location) || stringInSlice(syntheticsPublicLocations, location) {
+			continue
+		}
+
+		unknown = append(unknown, location)
 	}
 
-	readSyntheticsMonitorStruct(monitor, d)
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown synthetics locations: %s (valid public locations are %s)", strings.Join(unknown, ", "), strings.Join(syntheticsPublicLocations, ", "))
+	}
 
 	return nil
 }
 
-func resourceNewRelicSyntheticsMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ProviderConfig).NewClient
-	log.Printf("[INFO] Updating New Relic Synthetics monitor %s", d.Id())
+// expandSyntheticsMonitorOptions builds the monitor options in the order the
+// monitor applies them: a redirect fails the check when
+// treat_redirect_as_failure is set, before the response is validated;
+// otherwise redirects are followed and validation_string is checked against
+// the final response.
+//
+// A HEAD request returns no body to validate, so unless the provider asks for
+// strict passthrough, bypass_head_request defaults to true for monitors with
+// a validation string.
+func expandSyntheticsMonitorOptions(d *schema.ResourceData, providerConfig *ProviderConfig) synthetics.MonitorOptions {
+	options := synthetics.MonitorOptions{}
+
+	if verifySSL, ok := d.GetOkExists("verify_ssl"); ok {
+		options.VerifySSL = verifySSL.(bool)
+	}
+
+	if ssl, ok := d.GetOk("ssl"); ok {
+		if cfg, ok := ssl.([]interface{})[0].(map[string]interface{}); ok {
+			options.VerifySSL = cfg["verify_certificate"].(bool)
+		}
+	}
+
+	if options.VerifySSL && syntheticsMonitorSelfSignedGraceActive(d) {
+		log.Printf("[INFO] Disabling certificate validation for New Relic Synthetics monitor %q until %s", d.Get("name").(string), d.Get("allow_self_signed_until").(string))
+		options.VerifySSL = false
+	}
+
+	if treatRedirectAsFailure, ok := d.GetOkExists("treat_redirect_as_failure"); ok {
+		options.TreatRedirectAsFailure = treatRedirectAsFailure.(bool)
+	}
+
+	if validationString, ok := d.GetOk("validation_string"); ok {
+		options.ValidationString = validationString.(string)
+	}
+
+	if validationString, ok := d.GetOk("validation_string_secret"); ok {
+		options.ValidationString = validationString.(string)
+	}
+
+	strict := providerConfig != nil && providerConfig.StrictSyntheticsMonitorOptions
+
+	switch {
+	case isSyntheticsMonitorAttributeConfigured(d, "bypass_head_request"):
+		options.BypassHEADRequest = d.Get("bypass_head_request").(bool)
+	case options.ValidationString != "" && !strict:
+		log.Printf("[INFO] Enabling bypass_head_request for New Relic Synthetics monitor %q because validation_string is set", d.Get("name").(string))
+		options.BypassHEADRequest = true
+	}
+
+	return options
+}
 
-	_, err := client.Synthetics.UpdateMonitorWithContext(ctx, *buildSyntheticsUpdateMonitorArgs(d))
+// syntheticsMonitorSelfSignedGraceActive reports whether the monitor's
+// allow_self_signed_until is in the future.
+func syntheticsMonitorSelfSignedGraceActive(d *schema.ResourceData) bool {
+	until, err := time.Parse(time.RFC3339, d.Get("allow_self_signed_until").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return false
 	}
 
-	return resourceNewRelicSyntheticsMonitorRead(ctx, d, meta)
+	return time.Now().Before(until)
 }
 
-func resourceNewRelicSyntheticsMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*ProviderConfig).NewClient
+// syntheticsMonitorSelfSignedGraceDiags warns that certificate validation is
+// disabled while the monitor's allow_self_signed_until is in the future.
+func syntheticsMonitorSelfSignedGraceDiags(d *schema.ResourceData) diag.Diagnostics {
+	if !syntheticsMonitorSelfSignedGraceActive(d) {
+		return nil
+	}
 
-	log.Printf("[INFO] Deleting New Relic Synthetics monitor %s", d.Id())
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Certificate validation of synthetics monitor %q is disabled until %s", d.Get("name").(string), d.Get("allow_self_signed_until").(string)),
+		Detail:   "Apply the configuration again after that time to enable it.",
+	}}
+}
 
-	if err := client.Synthetics.DeleteMonitorWithContext(ctx, d.Id()); err != nil {
-		return diag.FromErr(err)
+// isSyntheticsMonitorAttributeConfigured reports whether the attribute is set
+// in the configuration, as opposed to carried over from state. When no raw
+// configuration is available it falls back to GetOkExists.
+func isSyntheticsMonitorAttributeConfigured(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		_, ok := d.GetOkExists(key)
+		return ok
 	}
 
-	return nil
+	return !rawConfig.GetAttr(key).IsNull()
+}
+
+func flattenSyntheticsMonitorSSL(options synthetics.MonitorOptions) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"verify_certificate": options.VerifySSL,
+		},
+	}
+}
+
+// syntheticsMonitorValidationMode describes how a monitor with the given
+// options treats redirects and the validation string.
+func syntheticsMonitorValidationMode(options synthetics.MonitorOptions) string {
+	switch {
+	case options.TreatRedirectAsFailure && options.ValidationString != "":
+		return "FAIL_ON_REDIRECT_THEN_VALIDATE"
+	case options.TreatRedirectAsFailure:
+		return "FAIL_ON_REDIRECT"
+	case options.ValidationString != "":
+		return "VALIDATE_FINAL_RESPONSE"
+	}
+
+	return "NONE"
+}
+
+// expandSyntheticsMonitorName prepends the provider's monitor_name_prefix to
+// the name, unless the name already starts with it.
+func expandSyntheticsMonitorName(name string, providerConfig *ProviderConfig) string {
+	if providerConfig == nil || providerConfig.MonitorNamePrefix == "" || strings.HasPrefix(name, providerConfig.MonitorNamePrefix) {
+		return name
+	}
+
+	return providerConfig.MonitorNamePrefix + name
+}
+
+// flattenSyntheticsMonitorName returns the name to store for the live
+// monitor's name. The name in state is kept when it expands to the live name,
+// whether or not it was configured with the provider's monitor_name_prefix;
+// otherwise the prefix is stripped from the live name.
+func flattenSyntheticsMonitorName(name string, liveName string, providerConfig *ProviderConfig) string {
+	if providerConfig == nil || providerConfig.MonitorNamePrefix == "" {
+		return liveName
+	}
+
+	if name != "" && expandSyntheticsMonitorName(name, providerConfig) == liveName {
+		return name
+	}
+
+	return strings.TrimPrefix(liveName, providerConfig.MonitorNamePrefix)
+}
+
+func buildSyntheticsMonitorStruct(d *schema.ResourceData, providerConfig *ProviderConfig) synthetics.Monitor {
+	// Muting only silences alerts: a MUTED monitor still runs and its checks
+	// count toward the SLA report, so the threshold is sent for every status.
+	monitor := synthetics.Monitor{
+		Name:         expandSyntheticsMonitorName(d.Get("name").(string), providerConfig),
+		Type:         synthetics.MonitorType(d.Get("type").(string)),
+		Frequency:    uint(d.Get("frequency").(int)),
+		Status:       synthetics.MonitorStatusType(d.Get("status").(string)),
+		SLAThreshold: d.Get("sla_threshold").(float64),
+	}
+
+	monitor.URI = expandSyntheticsMonitorURI(d)
+
+	monitor.Options = expandSyntheticsMonitorOptions(d, providerConfig)
+
+	monitor.Locations = expandSyntheticsMonitorLocations(d)
+	return monitor
+}
+
+func buildSyntheticsUpdateMonitorArgs(d *schema.ResourceData, providerConfig *ProviderConfig) *synthetics.Monitor {
+	monitor := synthetics.Monitor{
+		ID:           d.Id(),
+		Name:         expandSyntheticsMonitorName(d.Get("name").(string), providerConfig),
+		Type:         synthetics.MonitorType(d.Get("type").(string)),
+		Frequency:    uint(d.Get("frequency").(int)),
+		Status:       synthetics.MonitorStatusType(d.Get("status").(string)),
+		SLAThreshold: d.Get("sla_threshold").(float64),
+	}
+
+	monitor.URI = expandSyntheticsMonitorURI(d)
+
+	monitor.Options = expandSyntheticsMonitorOptions(d, providerConfig)
+
+	monitor.Locations = expandSyntheticsMonitorLocations(d)
+	return &monitor
+}
+
+// expandSyntheticsMonitorURI returns the URI selected from uri_candidates, or
+// the configured uri, with the query parameters appended.
+func expandSyntheticsMonitorURI(d *schema.ResourceData) string {
+	uri := d.Get("uri").(string)
+
+	if candidates := d.Get("uri_candidates").([]interface{}); len(candidates) > 0 {
+		uri = ""
+		if index := d.Get("primary_uri_index").(int); index >= 0 && index < len(candidates) {
+			uri = candidates[index].(string)
+		}
+	}
+
+	return syntheticsMonitorURIWithQuery(uri, d.Get("query_parameters").(map[string]interface{}))
+}
+
+// syntheticsMonitorURIWithQuery appends the query parameters to the URI,
+// sorted by key, replacing any the URI already has. The URI's other
+// parameters keep their order so they read back unchanged.
+func syntheticsMonitorURIWithQuery(uri string, params map[string]interface{}) string {
+	if len(params) == 0 {
+		return uri
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+
+	rest, _ := splitSyntheticsMonitorQuery(u.RawQuery, params)
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := []string{}
+	if rest != "" {
+		pairs = append(pairs, rest)
+	}
+	for _, k := range keys {
+		pairs = append(pairs, url.QueryEscape(k)+"="+url.QueryEscape(params[k].(string)))
+	}
+
+	u.RawQuery = strings.Join(pairs, "&")
+
+	return u.String()
+}
+
+// splitSyntheticsMonitorQuery removes the parameters named in params from a
+// raw query string, returning the rest of the query and the decoded values of
+// the removed parameters.
+func splitSyntheticsMonitorQuery(rawQuery string, params map[string]interface{}) (string, map[string]interface{}) {
+	rest := []string{}
+	found := map[string]interface{}{}
+
+	for _, pair := range strings.Split(rawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+
+		k, v, _ := strings.Cut(pair, "=")
+		if key, err := url.QueryUnescape(k); err == nil {
+			if _, ok := params[key]; ok {
+				if value, err := url.QueryUnescape(v); err == nil {
+					found[key] = value
+					continue
+				}
+			}
+		}
+
+		rest = append(rest, pair)
+	}
+
+	return strings.Join(rest, "&"), found
+}
+
+// readSyntheticsMonitorURI reads the live URI back into uri, or into
+// primary_uri_index when the configuration uses uri_candidates. Parameters
+// managed through query_parameters are split off the URI first.
+func readSyntheticsMonitorURI(d *schema.ResourceData, uri string) {
+	if params := d.Get("query_parameters").(map[string]interface{}); len(params) > 0 {
+		if u, err := url.Parse(uri); err == nil {
+			rest, found := splitSyntheticsMonitorQuery(u.RawQuery, params)
+			u.RawQuery = rest
+			uri = u.String()
+			_ = d.Set("query_parameters", found)
+		}
+	}
+
+	candidates := d.Get("uri_candidates").([]interface{})
+	if len(candidates) == 0 {
+		_ = d.Set("uri", uri)
+		return
+	}
+
+	index := -1
+	for i, c := range candidates {
+		if normalizeSyntheticsMonitorURI(c.(string)) == normalizeSyntheticsMonitorURI(uri) {
+			index = i
+			break
+		}
+	}
+
+	_ = d.Set("primary_uri_index", index)
+}
+
+// expandSyntheticsMonitorLocations returns the configured locations, plus the
+// locations added outside of Terraform when ignore_external_locations is set,
+// sorted so requests don't depend on the set's hash order.
+func expandSyntheticsMonitorLocations(d *schema.ResourceData) []string {
+	locationsRaw := d.Get("locations").(*schema.Set)
+	if d.Get("ignore_external_locations").(bool) {
+		locationsRaw = locationsRaw.Union(d.Get("external_locations").(*schema.Set))
+	}
+
+	locations := make([]string, locationsRaw.Len())
+	for i, v := range locationsRaw.List() {
+		locations[i] = fmt.Sprint(v)
+	}
+	sort.Strings(locations)
+
+	return locations
+}
+
+// readSyntheticsMonitorExternalLocations splits the monitor's live locations
+// into the managed ones, kept in `locations`, and the ones added outside of
+// Terraform, kept in `external_locations`, so the latter don't show up as a
+// diff. Without managed locations to compare with (e.g. on import) every
+// location is managed.
+func readSyntheticsMonitorExternalLocations(d *schema.ResourceData, managed *schema.Set, live []string) {
+	external := schema.NewSet(schema.HashString, nil)
+
+	if d.Get("ignore_external_locations").(bool) && managed.Len() > 0 {
+		locations := schema.NewSet(schema.HashString, nil)
+		for _, l := range live {
+			if managed.Contains(l) {
+				locations.Add(l)
+			} else {
+				external.Add(l)
+			}
+		}
+		_ = d.Set("locations", locations)
+	}
+
+	_ = d.Set("external_locations", external)
+}
+
+func readSyntheticsMonitorStruct(monitor *synthetics.Monitor, d *schema.ResourceData) {
+	_ = d.Set("name", monitor.Name)
+	_ = d.Set("type", monitor.Type)
+	_ = d.Set("frequency", monitor.Frequency)
+	readSyntheticsMonitorURI(d, monitor.URI)
+	locations := append([]string{}, monitor.Locations...)
+	sort.Strings(locations)
+	_ = d.Set("locations", locations)
+	_ = d.Set("status", monitor.Status)
+	_ = d.Set("sla_threshold", normalizeSyntheticsMonitorSLAThreshold(monitor.SLAThreshold))
+	// Only read back the options the monitor's type uses. The API returns
+	// the others with arbitrary values, which would diff against whatever
+	// the configuration still holds.
+	monitorType := string(monitor.Type)
+	// While allow_self_signed_until is in the future the API reports
+	// validation as disabled, so keep the configured value to avoid a diff.
+	// Once it has passed, the disabled validation reads back and plans an
+	// update.
+	if syntheticsMonitorTypeSupportsOption(monitorType, "verify_ssl") && !syntheticsMonitorSelfSignedGraceActive(d) {
+		// Read the SSL setting back into whichever form the configuration uses.
+		if _, ok := d.GetOk("ssl"); ok {
+			_ = d.Set("ssl", flattenSyntheticsMonitorSSL(monitor.Options))
+		} else {
+			_ = d.Set("verify_ssl", monitor.Options.VerifySSL)
+		}
+	}
+	if syntheticsMonitorTypeSupportsOption(monitorType, "validation_string") {
+		// Keep a secret validation string out of the non-sensitive attribute.
+		if _, ok := d.GetOk("validation_string_secret"); ok {
+			_ = d.Set("validation_string_secret", monitor.Options.ValidationString)
+		} else {
+			_ = d.Set("validation_string", monitor.Options.ValidationString)
+		}
+	}
+	if syntheticsMonitorTypeSupportsOption(monitorType, "bypass_head_request") {
+		_ = d.Set("bypass_head_request", monitor.Options.BypassHEADRequest)
+	}
+	if syntheticsMonitorTypeSupportsOption(monitorType, "treat_redirect_as_failure") {
+		_ = d.Set("treat_redirect_as_failure", monitor.Options.TreatRedirectAsFailure)
+	}
+	_ = d.Set("config_checksum", syntheticsMonitorChecksum(monitor))
+	// The REST API returns the monitor ID whatever ID the monitor is tracked
+	// by, so the API is told by the resource ID. Monitors tracked by entity
+	// GUID move to their monitor ID once read, so keep NERDGRAPH for them.
+	if apiSource := syntheticsMonitorAPISource(d.Id()); apiSource == "NERDGRAPH" || d.Get("api_source").(string) == "" {
+		_ = d.Set("api_source", apiSource)
+	}
+	_ = d.Set("effective_validation_mode", syntheticsMonitorValidationMode(monitor.Options))
+}
+
+// syntheticsMonitorSLAThresholdPrecision is the number of decimal places of
+// the SLA threshold kept in state, i.e. millisecond precision.
+const syntheticsMonitorSLAThresholdPrecision = 1000
+
+// normalizeSyntheticsMonitorSLAThreshold rounds an SLA threshold read from the
+// API, which may come back as e.g. 6.9999999 for 7, to millisecond precision.
+func normalizeSyntheticsMonitorSLAThreshold(v float64) float64 {
+	return math.Round(v*syntheticsMonitorSLAThresholdPrecision) / syntheticsMonitorSLAThresholdPrecision
+}
+
+// syntheticsMonitorSLAThresholdsEqual reports whether two SLA thresholds, as
+// formatted in a diff, are equal to millisecond precision.
+func syntheticsMonitorSLAThresholdsEqual(old, new string) bool {
+	o, err := strconv.ParseFloat(old, 64)
+	if err != nil {
+		return false
+	}
+
+	n, err := strconv.ParseFloat(new, 64)
+	if err != nil {
+		return false
+	}
+
+	return normalizeSyntheticsMonitorSLAThreshold(o) == normalizeSyntheticsMonitorSLAThreshold(n)
+}
+
+// syntheticsMonitorTypeOptions lists the options each monitor type uses. Types
+// missing from the map, such as scripted and certificate check monitors, use
+// none of them.
+var syntheticsMonitorTypeOptions = map[string][]string{
+	"SIMPLE":  {"validation_string", "verify_ssl", "bypass_head_request", "treat_redirect_as_failure"},
+	"BROWSER": {"validation_string", "verify_ssl"},
+}
+
+// syntheticsMonitorTypeSupportsOption reports whether monitors of the given
+// type use the option attribute. Unknown types are assumed to use every
+// option, so that new types keep reading them back.
+func syntheticsMonitorTypeSupportsOption(monitorType, option string) bool {
+	if !stringInSlice(syntheticsMonitorTypes, monitorType) {
+		return true
+	}
+
+	return stringInSlice(syntheticsMonitorTypeOptions[monitorType], option)
+}
+
+// normalizeSyntheticsMonitorURI returns the URI in the form the API stores
+// it in, so that URIs differing only in scheme or host case, a default port
+// or a trailing slash compare equal. URIs that don't parse are returned
+// unchanged.
+func normalizeSyntheticsMonitorURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" {
+		return uri
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if (u.Scheme == "https" && u.Port() == "443") || (u.Scheme == "http" && u.Port() == "80") {
+		u.Host = u.Hostname()
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = strings.TrimSuffix(u.RawPath, "/")
+
+	return u.String()
+}
+
+// syntheticsMonitorChecksum returns a SHA-256 hex digest over a canonical
+// representation of the monitor's configuration. The representation is built
+// field by field (rather than by marshalling the client struct) so that the
+// checksum stays stable across provider and client versions.
+func syntheticsMonitorChecksum(monitor *synthetics.Monitor) string {
+	locations := make([]string, len(monitor.Locations))
+	copy(locations, monitor.Locations)
+	sort.Strings(locations)
+
+	fields := []string{
+		"name=" + monitor.Name,
+		"type=" + string(monitor.Type),
+		"frequency=" + strconv.FormatUint(uint64(monitor.Frequency), 10),
+		"uri=" + monitor.URI,
+		"locations=" + strings.Join(locations, ","),
+		"status=" + string(monitor.Status),
+		"sla_threshold=" + strconv.FormatFloat(monitor.SLAThreshold, 'f', -1, 64),
+		"validation_string=" + monitor.Options.ValidationString,
+		"verify_ssl=" + strconv.FormatBool(monitor.Options.VerifySSL),
+		"bypass_head_request=" + strconv.FormatBool(monitor.Options.BypassHEADRequest),
+		"treat_redirect_as_failure=" + strconv.FormatBool(monitor.Options.TreatRedirectAsFailure),
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// syntheticsMonitorGUID returns the entity GUID of a Synthetics monitor, which
+// is the unpadded base64 encoding of `<accountID>|SYNTH|MONITOR|<monitorID>`.
+func syntheticsMonitorGUID(accountID int, monitorID string) common.EntityGUID {
+	raw := fmt.Sprintf("%d|SYNTH|MONITOR|%s", accountID, monitorID)
+
+	return common.EntityGUID(base64.RawStdEncoding.EncodeToString([]byte(raw)))
+}
+
+// syntheticsMonitorAPISource reports which API a monitor ID belongs to. The
+// Synthetics REST API identifies monitors by UUID, while NerdGraph uses the
+// monitor's entity GUID.
+func syntheticsMonitorAPISource(id string) string {
+	raw, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(id, "="))
+	if err == nil && strings.Contains(string(raw), "|SYNTH|MONITOR|") {
+		return "NERDGRAPH"
+	}
+
+	return "REST"
+}
+
+// expandSyntheticsMonitorTags merges the monitor's `tag` blocks with the
+// provider default tags planned for it.
+func expandSyntheticsMonitorTags(tags interface{}, providerTags interface{}) []entities.TaggingTagInput {
+	out := expandEntityTags(tags.(*schema.Set).List())
+
+	defaults := providerTags.(map[string]interface{})
+	keys := make([]string, 0, len(defaults))
+	for k := range defaults {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		out = append(out, entities.TaggingTagInput{Key: k, Values: []string{defaults[k].(string)}})
+	}
+
+	return out
+}
+
+// updateSyntheticsMonitorTags applies the monitor's tag changes. A missing
+// tagging permission, or tags rejected while others were applied, are
+// downgraded to a warning unless `require_tags` is set, since the monitor
+// itself has already been saved at this point.
+func updateSyntheticsMonitorTags(ctx context.Context, d *schema.ResourceData, providerConfig *ProviderConfig, oldTags []entities.TaggingTagInput, newTags []entities.TaggingTagInput) diag.Diagnostics {
+	accountID := selectAccountID(providerConfig, d)
+	guid := syntheticsMonitorGUID(accountID, d.Id())
+
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Updating tags for New Relic Synthetics monitor %s", d.Id())
+
+	err = updateEntityTags(ctx, client, guid, oldTags, newTags)
+	if err == nil {
+		return nil
+	}
+
+	if partial, ok := err.(*entityTaggingPartialError); ok {
+		severity := diag.Warning
+		if d.Get("require_tags").(bool) {
+			severity = diag.Error
+		}
+
+		return diag.Diagnostics{
+			{
+				Severity: severity,
+				Summary:  fmt.Sprintf("Some tags could not be applied to synthetics monitor %s", d.Id()),
+				Detail:   fmt.Sprintf("The API rejected some of the monitor's tags. The other tags were applied, and the rejected ones will show as changes on the next plan.\n\n%s", partial),
+			},
+		}
+	}
+
+	if !isEntityTaggingPermissionError(err) || d.Get("require_tags").(bool) {
+		return diag.Errorf("error tagging synthetics monitor %s: %s", d.Id(), err)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Tags could not be applied to synthetics monitor %s", d.Id()),
+			Detail:   fmt.Sprintf("The API key is not permitted to tag entities, so the monitor was saved without its tags. Set require_tags = true to treat this as an error.\n\n%s", err),
+		},
+	}
+}
+
+// createSyntheticsMonitor creates a monitor and returns its ID. The ID is also
+// returned when only muting the new monitor failed, so that it is still tracked.
+func createSyntheticsMonitor(ctx context.Context, client *nr.NewRelic, monitorStruct synthetics.Monitor) (string, error) {
+	// The API rejects creating some monitor types directly in the MUTED state,
+	// so those are created ENABLED and muted with a follow-up update.
+	muteAfterCreate := monitorStruct.Status == synthetics.MonitorStatus.Muted
+	if muteAfterCreate {
+		monitorStruct.Status = synthetics.MonitorStatus.Enabled
+	}
+
+	monitor, err := client.Synthetics.CreateMonitorWithContext(ctx, monitorStruct)
+	if err != nil {
+		return "", err
+	}
+
+	if muteAfterCreate {
+		log.Printf("[INFO] Muting New Relic Synthetics monitor %s", monitor.ID)
+
+		monitorStruct.ID = monitor.ID
+		monitorStruct.Status = synthetics.MonitorStatus.Muted
+
+		if _, err := client.Synthetics.UpdateMonitorWithContext(ctx, monitorStruct); err != nil {
+			return monitor.ID, fmt.Errorf("error muting synthetics monitor %s after creation: %s", monitor.ID, err)
+		}
+	}
+
+	return monitor.ID, nil
+}
+
+func resourceNewRelicSyntheticsMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	ctx, cancel := providerConfig.operationContext(ctx, operationCreate)
+	defer cancel()
+
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("read_only").(bool) {
+		return adoptSyntheticsMonitor(ctx, d, meta, client)
+	}
+
+	monitorStruct := buildSyntheticsMonitorStruct(d, providerConfig)
+
+	log.Printf("[INFO] Creating New Relic Synthetics monitor %s", monitorStruct.Name)
+
+	// Track the monitor as soon as it exists. If muting, tagging or the
+	// final read fails, the error is returned with the ID kept in state, so
+	// the next apply works on this monitor instead of creating another one.
+	id, err := createSyntheticsMonitor(ctx, client, monitorStruct)
+	if id != "" {
+		d.SetId(id)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := recordSyntheticsMonitorOperation(providerConfig, "create", id, monitorStruct.Name, nil)
+	diags = append(diags, syntheticsMonitorSelfSignedGraceDiags(d)...)
+
+	if providerConfig.CheckPrivateLocationCapacity {
+		diags = append(diags, checkSyntheticsPrivateLocationCapacity(ctx, d, client, selectAccountID(providerConfig, d))...)
+	}
+
+	if tags := expandSyntheticsMonitorTags(d.Get("tag"), d.Get("provider_tags")); len(tags) > 0 {
+		diags = append(diags, updateSyntheticsMonitorTags(ctx, d, providerConfig, nil, tags)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if _, ok := d.GetOk("alert"); ok {
+		diags = append(diags, syncSyntheticsMonitorAlert(ctx, d, client, selectAccountID(providerConfig, d))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if _, ok := d.GetOk("location_routing"); ok {
+		diags = append(diags, syncSyntheticsMonitorLocationRouting(ctx, d, providerConfig, client, selectAccountID(providerConfig, d))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if _, ok := d.GetOk("slo"); ok {
+		diags = append(diags, syncSyntheticsMonitorSLO(ctx, d, client, selectAccountID(providerConfig, d), "")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if workloadID, ok := d.GetOk("workload_id"); ok {
+		if err := setSyntheticsMonitorWorkloadMembership(ctx, client, workloadID.(string), syntheticsMonitorGUID(selectAccountID(providerConfig, d), d.Id()), true); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	// The monitor stays in state when its first check fails, so Terraform
+	// replaces it on the next apply.
+	if d.Get("verify_on_create").(bool) {
+		if err := waitForSyntheticsMonitorFirstCheck(ctx, client, selectAccountID(providerConfig, d), d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return append(diags, resourceNewRelicSyntheticsMonitorRead(ctx, d, meta)...)
+}
+
+// adoptSyntheticsMonitor brings the existing monitor with the configured name
+// into state instead of creating one.
+func adoptSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}, client *nr.NewRelic) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Adopting New Relic Synthetics monitor %s", name)
+
+	monitors, err := client.Synthetics.ListMonitorsWithContext(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ids []string
+	for _, m := range monitors {
+		if m.Name == name {
+			ids = append(ids, m.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return diag.Errorf("read_only is set but there is no synthetics monitor named %q to adopt", name)
+	case 1:
+		d.SetId(ids[0])
+	default:
+		return diag.Errorf("read_only is set but %d synthetics monitors are named %q (%s); import the one to adopt instead", len(ids), name, strings.Join(ids, ", "))
+	}
+
+	diags := readOnlySyntheticsMonitorDiags(ctx, d, meta.(*ProviderConfig), client)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceNewRelicSyntheticsMonitorRead(ctx, d, meta)...)
+}
+
+// readOnlySyntheticsMonitorDiags warns about configured values that differ
+// from the live monitor and were therefore not applied.
+func readOnlySyntheticsMonitorDiags(ctx context.Context, d *schema.ResourceData, providerConfig *ProviderConfig, client *nr.NewRelic) diag.Diagnostics {
+	live, err := client.Synthetics.GetMonitorWithContext(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Attributes left out of the configuration are taken from the live
+	// monitor and can't differ.
+	desired := buildSyntheticsMonitorStruct(d, providerConfig)
+
+	var changed []string
+	for _, k := range diffSyntheticsMonitors(&desired, live) {
+		configured := isSyntheticsMonitorAttributeConfigured(d, k)
+		switch k {
+		case "validation_string":
+			configured = configured || isSyntheticsMonitorAttributeConfigured(d, "validation_string_secret")
+		case "verify_ssl":
+			configured = configured || isSyntheticsMonitorAttributeConfigured(d, "ssl")
+		}
+
+		if configured {
+			changed = append(changed, k)
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Synthetics monitor %s is read-only, configuration was not applied", d.Id()),
+		Detail:   fmt.Sprintf("The configuration differs from the live monitor in: %s. Unset read_only to apply it.", strings.Join(changed, ", ")),
+	}}
+}
+
+// liveSyntheticsMonitorDriftDiags warns about attributes of the live monitor
+// that differ from state, i.e. were changed outside of Terraform. Unlike the
+// plan, it also reports changes hidden by diff suppression, ignore_changes or
+// ignore_external_locations.
+func liveSyntheticsMonitorDriftDiags(d *schema.ResourceData, providerConfig *ProviderConfig, live *synthetics.Monitor) diag.Diagnostics {
+	// Nothing to compare against while importing.
+	if d.Get("name").(string) == "" {
+		return nil
+	}
+
+	desired := buildSyntheticsMonitorStruct(d, providerConfig)
+
+	changed := diffSyntheticsMonitors(&desired, live)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Synthetics monitor %s was changed outside of Terraform", d.Id()),
+		Detail:   fmt.Sprintf("The live monitor differs from state in: %s.", strings.Join(changed, ", ")),
+	}}
+}
+
+// diffSyntheticsMonitors returns the attributes that differ between the
+// desired and live monitor.
+func diffSyntheticsMonitors(desired *synthetics.Monitor, live *synthetics.Monitor) []string {
+	desiredLocations := append([]string{}, desired.Locations...)
+	liveLocations := append([]string{}, live.Locations...)
+	sort.Strings(desiredLocations)
+	sort.Strings(liveLocations)
+
+	// The API returns arbitrary values for options the monitor's type doesn't
+	// use, so they never count as changed.
+	monitorType := string(live.Type)
+	optionEqual := func(option string, equal bool) bool {
+		return equal || !syntheticsMonitorTypeSupportsOption(monitorType, option)
+	}
+
+	var changed []string
+	for _, f := range []struct {
+		name  string
+		equal bool
+	}{
+		{"name", desired.Name == live.Name},
+		{"frequency", desired.Frequency == live.Frequency},
+		{"uri", normalizeSyntheticsMonitorURI(desired.URI) == normalizeSyntheticsMonitorURI(live.URI)},
+		{"locations", strings.Join(desiredLocations, ",") == strings.Join(liveLocations, ",")},
+		{"status", desired.Status == live.Status},
+		{"sla_threshold", normalizeSyntheticsMonitorSLAThreshold(desired.SLAThreshold) == normalizeSyntheticsMonitorSLAThreshold(live.SLAThreshold)},
+		{"validation_string", optionEqual("validation_string", desired.Options.ValidationString == live.Options.ValidationString)},
+		{"verify_ssl", optionEqual("verify_ssl", desired.Options.VerifySSL == live.Options.VerifySSL)},
+		{"bypass_head_request", optionEqual("bypass_head_request", desired.Options.BypassHEADRequest == live.Options.BypassHEADRequest)},
+		{"treat_redirect_as_failure", optionEqual("treat_redirect_as_failure", desired.Options.TreatRedirectAsFailure == live.Options.TreatRedirectAsFailure)},
+	} {
+		if !f.equal {
+			changed = append(changed, f.name)
+		}
+	}
+
+	return changed
+}
+
+func resourceNewRelicSyntheticsMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+
+	ctx, cancel := providerConfig.operationContext(ctx, operationRead)
+	defer cancel()
+
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading New Relic Synthetics monitor %s", d.Id())
+
+	monitor, err := getSyntheticsMonitor(ctx, providerConfig, client, d.Id())
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	managedLocations := d.Get("locations").(*schema.Set)
+	name := d.Get("name").(string)
+
+	var diags diag.Diagnostics
+	if providerConfig.PlanVerifyLive {
+		diags = liveSyntheticsMonitorDriftDiags(d, providerConfig, monitor)
+	}
+
+	_ = d.Set("account_id", accountID)
+	readSyntheticsMonitorStruct(monitor, d)
+	// Track monitors imported by entity GUID by their monitor ID, which the
+	// API calls and entity GUIDs of the rest of the resource are built from.
+	d.SetId(monitor.ID)
+	_ = d.Set("name", flattenSyntheticsMonitorName(name, monitor.Name, providerConfig))
+	readSyntheticsMonitorExternalLocations(d, managedLocations, monitor.Locations)
+
+	if _, ok := d.GetOk("tag"); ok {
+		t, err := client.Entities.GetTagsForEntityWithContextMutable(ctx, syntheticsMonitorGUID(accountID, d.Id()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set("tag", flattenSyntheticsMonitorTags(convertTagTypes(t), d.Get("provider_tags").(map[string]interface{}))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := readSyntheticsMonitorAlert(ctx, d, client, accountID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := readSyntheticsMonitorLocationRouting(ctx, d, client, accountID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := readSyntheticsMonitorSLO(ctx, d, client, accountID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Drop a workload the monitor was removed from, or that was deleted, so
+	// the next apply adds the monitor back or reports the missing workload.
+	if workloadID, ok := d.GetOk("workload_id"); ok {
+		workload, err := getSyntheticsMonitorWorkload(ctx, client, workloadID.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if workload == nil || !workloadHasEntity(workload, string(syntheticsMonitorGUID(accountID, d.Id()))) {
+			log.Printf("[WARN] New Relic Synthetics monitor %s is not part of workload %s", d.Id(), workloadID)
+			_ = d.Set("workload_id", "")
+		}
+	}
+
+	nextRunAt := ""
+	if d.Get("fetch_schedule").(bool) && monitor.Status != synthetics.MonitorStatus.Disabled {
+		nextRunAt, err = getSyntheticsMonitorNextRunAt(ctx, client, accountID, d.Id(), int(monitor.Frequency), time.Now())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	_ = d.Set("next_run_at", nextRunAt)
+
+	if d.Get("fetch_alert_conditions").(bool) {
+		ids, err := listSyntheticsMonitorAlertConditionIDs(ctx, client, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set("alert_condition_ids", ids); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+// listSyntheticsMonitorAlertConditionIDs returns the IDs of the synthetics and
+// multi-location synthetics alert conditions that reference a monitor.
+func listSyntheticsMonitorAlertConditionIDs(ctx context.Context, client *nr.NewRelic, monitorID string) ([]string, error) {
+	byMonitor, err := listSyntheticsAlertConditionIDsByMonitor(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if ids, ok := byMonitor[monitorID]; ok {
+		return ids, nil
+	}
+
+	return []string{}, nil
+}
+
+// listSyntheticsAlertConditionIDsByMonitor maps monitor IDs to the IDs
+// (<policy_id>:<condition_id>) of the synthetics and multi-location synthetics
+// alert conditions referencing them. The alerts API can only list conditions
+// per policy, so every policy is visited.
+func listSyntheticsAlertConditionIDsByMonitor(ctx context.Context, client *nr.NewRelic) (map[string][]string, error) {
+	policies, err := client.Alerts.ListPoliciesWithContext(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byMonitor := map[string][]string{}
+
+	for _, policy := range policies {
+		conditions, err := client.Alerts.ListSyntheticsConditionsWithContext(ctx, policy.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range conditions {
+			byMonitor[c.MonitorID] = append(byMonitor[c.MonitorID], serializeIDs([]int{policy.ID, c.ID}))
+		}
+
+		multiLocationConditions, err := client.Alerts.ListMultiLocationSyntheticsConditionsWithContext(ctx, policy.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range multiLocationConditions {
+			for _, monitorID := range c.Entities {
+				byMonitor[monitorID] = append(byMonitor[monitorID], serializeIDs([]int{policy.ID, c.ID}))
+			}
+		}
+	}
+
+	return byMonitor, nil
+}
+
+// flattenSyntheticsMonitorTags returns the monitor's tags that are managed
+// through `tag` blocks, leaving out system tags and provider default tags.
+func flattenSyntheticsMonitorTags(tags []*entities.TaggingTagInput, providerTags map[string]interface{}) []map[string]interface{} {
+	out := []map[string]interface{}{}
+	for _, t := range tags {
+		if stringInSlice(defaultTags, t.Key) {
+			continue
+		}
+
+		if _, ok := providerTags[t.Key]; ok {
+			continue
+		}
+
+		out = append(out, map[string]interface{}{
+			"key":    t.Key,
+			"values": t.Values,
+		})
+	}
+
+	return out
+}
+
+func resourceNewRelicSyntheticsMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	ctx, cancel := providerConfig.operationContext(ctx, operationUpdate)
+	defer cancel()
+
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("read_only").(bool) {
+		diags := readOnlySyntheticsMonitorDiags(ctx, d, providerConfig, client)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, resourceNewRelicSyntheticsMonitorRead(ctx, d, meta)...)
+	}
+
+	log.Printf("[INFO] Updating New Relic Synthetics monitor %s", d.Id())
+
+	_, err = client.Synthetics.UpdateMonitorWithContext(ctx, *buildSyntheticsUpdateMonitorArgs(d, providerConfig))
+	if err != nil {
+		if providerConfig.RecreateOnUpdateError && isSyntheticsMonitorNotUpdatableError(err) {
+			return recreateSyntheticsMonitor(ctx, d, meta, client, err)
+		}
+
+		return diag.FromErr(err)
+	}
+
+	diags := recordSyntheticsMonitorOperation(providerConfig, "update", d.Id(), d.Get("name").(string), syntheticsMonitorChangedFields(d))
+	diags = append(diags, syntheticsMonitorSelfSignedGraceDiags(d)...)
+
+	if providerConfig.CheckPrivateLocationCapacity && d.HasChanges("frequency", "locations") {
+		diags = append(diags, checkSyntheticsPrivateLocationCapacity(ctx, d, client, selectAccountID(providerConfig, d))...)
+	}
+
+	if d.HasChange("status") && !d.Get("skip_status_wait").(bool) {
+		status := synthetics.MonitorStatusType(d.Get("status").(string))
+		if err := waitForSyntheticsMonitorStatus(ctx, client, d.Id(), status, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("tag", "provider_tags") {
+		oldTags, newTags := d.GetChange("tag")
+		oldProviderTags, newProviderTags := d.GetChange("provider_tags")
+		diags = append(diags, updateSyntheticsMonitorTags(ctx, d, providerConfig, expandSyntheticsMonitorTags(oldTags, oldProviderTags), expandSyntheticsMonitorTags(newTags, newProviderTags))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChanges("alert", "name") {
+		diags = append(diags, syncSyntheticsMonitorAlert(ctx, d, client, selectAccountID(providerConfig, d))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChanges("location_routing", "name") {
+		diags = append(diags, syncSyntheticsMonitorLocationRouting(ctx, d, providerConfig, client, selectAccountID(providerConfig, d))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChanges("slo", "name") {
+		o, _ := d.GetChange("slo")
+		diags = append(diags, syncSyntheticsMonitorSLO(ctx, d, client, selectAccountID(providerConfig, d), syntheticsMonitorSLOID(o))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("workload_id") {
+		o, n := d.GetChange("workload_id")
+		monitorGUID := syntheticsMonitorGUID(selectAccountID(providerConfig, d), d.Id())
+
+		if o.(string) != "" {
+			if err := setSyntheticsMonitorWorkloadMembership(ctx, client, o.(string), monitorGUID, false); err != nil {
+				log.Printf("[WARN] Could not remove New Relic Synthetics monitor %s from workload %s: %s", d.Id(), o, err)
+			}
+		}
+
+		if n.(string) != "" {
+			if err := setSyntheticsMonitorWorkloadMembership(ctx, client, n.(string), monitorGUID, true); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+	}
+
+	return append(diags, resourceNewRelicSyntheticsMonitorRead(ctx, d, meta)...)
+}
+
+// waitForSyntheticsMonitorStatus polls the monitor until it reports the given
+// status. Status transitions (e.g. DISABLED to ENABLED) aren't always visible
+// immediately, and reading too early produces a spurious diff.
+func waitForSyntheticsMonitorStatus(ctx context.Context, client *nr.NewRelic, monitorID string, status synthetics.MonitorStatusType, timeout time.Duration) error {
+	pending := []string{}
+	for _, s := range []synthetics.MonitorStatusType{synthetics.MonitorStatus.Enabled, synthetics.MonitorStatus.Muted, synthetics.MonitorStatus.Disabled} {
+		if s != status {
+			pending = append(pending, string(s))
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{string(status)},
+		Refresh: func() (interface{}, string, error) {
+			monitor, err := client.Synthetics.GetMonitorWithContext(ctx, monitorID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return monitor, string(monitor.Status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for synthetics monitor %s to become %s: %w", monitorID, status, err)
+	}
+
+	return nil
+}
+
+// getSyntheticsMonitorNextRunAt estimates when the monitor next runs from its
+// latest check and its frequency. Checks at the monitor's locations are
+// staggered, so the estimate is the next check at any location. It returns ""
+// when no check ran in the last two periods, or the estimate is already past,
+// since the monitor isn't running on schedule then.
+func getSyntheticsMonitorNextRunAt(ctx context.Context, client *nr.NewRelic, accountID int, monitorID string, frequency int, now time.Time) (string, error) {
+	if frequency <= 0 {
+		return "", nil
+	}
+
+	query := nrdb.NRQL(fmt.Sprintf("SELECT latest(timestamp) AS 'lastRun' FROM SyntheticCheck WHERE monitorId = '%s' SINCE %d minutes ago", escapeNRQLString(monitorID), 2*frequency))
+
+	result, err := client.Nrdb.QueryWithContext(ctx, accountID, query)
+	if err != nil {
+		return "", fmt.Errorf("error querying the latest check of synthetics monitor %s: %w", monitorID, err)
+	}
+
+	var row nrdb.NRDBResult
+	if len(result.Results) > 0 {
+		row = result.Results[0]
+	}
+
+	lastRun := nrdbResultFloat(row, "lastRun")
+	if lastRun == 0 {
+		return "", nil
+	}
+
+	next := time.UnixMilli(int64(lastRun)).Add(time.Duration(frequency) * time.Minute)
+	if next.Before(now) {
+		return "", nil
+	}
+
+	return next.UTC().Format(time.RFC3339), nil
+}
+
+// waitForSyntheticsMonitorFirstCheck waits for the first check of a new
+// monitor and returns an error if it failed. The Synthetics API can't run a
+// check on demand, so this polls the monitor's SyntheticCheck events for the
+// first scheduled check.
+func waitForSyntheticsMonitorFirstCheck(ctx context.Context, client *nr.NewRelic, accountID int, monitorID string, timeout time.Duration) error {
+	query := nrdb.NRQL(fmt.Sprintf("SELECT latest(result) AS 'result', latest(error) AS 'error' FROM SyntheticCheck WHERE monitorId = '%s' SINCE 1 hour ago", escapeNRQLString(monitorID)))
+
+	var checkResult, checkErr string
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"SUCCESS", "FAILED"},
+		Refresh: func() (interface{}, string, error) {
+			result, err := client.Nrdb.QueryWithContext(ctx, accountID, query)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if len(result.Results) == 0 {
+				return result, "PENDING", nil
+			}
+
+			checkResult, _ = result.Results[0]["result"].(string)
+			if checkResult == "" {
+				return result, "PENDING", nil
+			}
+
+			checkErr, _ = result.Results[0]["error"].(string)
+
+			if checkResult != "SUCCESS" {
+				return result, "FAILED", nil
+			}
+
+			return result, "SUCCESS", nil
+		},
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	log.Printf("[INFO] Waiting for the first check of New Relic Synthetics monitor %s", monitorID)
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the first check of synthetics monitor %s: %w", monitorID, err)
+	}
+
+	if checkResult != "SUCCESS" {
+		return fmt.Errorf("the first check of synthetics monitor %s returned %s: %s", monitorID, checkResult, checkErr)
+	}
+
+	return nil
+}
+
+// retrySyntheticsRateLimited calls fn until it succeeds, fails with an error
+// other than rate limiting, or timeout elapses. The client already retries
+// rate-limited requests a few times; this keeps going when e.g. destroying
+// hundreds of monitors exhausts those retries, instead of leaving the destroy
+// half done.
+func retrySyntheticsRateLimited(ctx context.Context, timeout time.Duration, fn func() error) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		if _, ok := err.(*errors.MaxRetriesReached); ok {
+			log.Printf("[WARN] New Relic API rate limit reached, retrying: %s", err)
+			return resource.RetryableError(err)
+		}
+
+		return resource.NonRetryableError(err)
+	})
+}
+
+// getSyntheticsMonitor reads a monitor, using the provider's batched listing
+// when batch_synthetics_monitor_reads is enabled. The listing only covers the
+// provider's default account, so monitors read with another client, and
+// monitors missing from the listing, are read individually.
+func getSyntheticsMonitor(ctx context.Context, providerConfig *ProviderConfig, client *nr.NewRelic, monitorID string) (*synthetics.Monitor, error) {
+	// Monitors tracked by entity GUID are fetched by the monitor ID the GUID
+	// encodes.
+	if syntheticsMonitorAPISource(monitorID) == "NERDGRAPH" {
+		id, err := syntheticsMonitorIDFromGUID(monitorID)
+		if err != nil {
+			return nil, err
+		}
+		monitorID = id
+	}
+
+	if providerConfig.BatchSyntheticsMonitorReads && client == providerConfig.NewClient {
+		if monitor, ok := providerConfig.syntheticsMonitorCache.take(ctx, client, monitorID); ok {
+			return monitor, nil
+		}
+	}
+
+	return client.Synthetics.GetMonitorWithContext(ctx, monitorID)
+}
+
+// isSyntheticsMonitorNotUpdatableError reports whether the API rejected an
+// update because one of the changed fields cannot be modified in place.
+func isSyntheticsMonitorNotUpdatableError(err error) bool {
+	if _, ok := err.(*errors.UnexpectedStatusCode); !ok {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	if !strings.HasPrefix(msg, "400 ") {
+		return false
+	}
+
+	for _, s := range []string{"not updatable", "cannot be updated", "can not be updated", "cannot be changed"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recreateSyntheticsMonitor replaces a monitor that can't be updated in place
+// with a new one built from the planned configuration. The new monitor is
+// created before the old one is deleted so a failed create leaves the
+// existing monitor (and state) untouched.
+func recreateSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}, client *nr.NewRelic, updateErr error) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	oldID := d.Id()
+
+	log.Printf("[WARN] New Relic Synthetics monitor %s can't be updated in place (%s), recreating it", oldID, updateErr)
+
+	id, err := createSyntheticsMonitor(ctx, client, buildSyntheticsMonitorStruct(d, providerConfig))
+	if id == "" {
+		return diag.Errorf("error recreating synthetics monitor %s after update failed with %q: %s", oldID, updateErr, err)
+	}
+
+	d.SetId(id)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	diags := recordSyntheticsMonitorOperation(providerConfig, "create", id, name, nil)
+
+	if err := client.Synthetics.DeleteMonitorWithContext(ctx, oldID); err != nil {
+		if _, ok := err.(*errors.NotFound); !ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Synthetics monitor %s was replaced by %s but could not be deleted", oldID, id),
+				Detail:   err.Error(),
+			})
+		}
+	} else {
+		diags = append(diags, recordSyntheticsMonitorOperation(providerConfig, "delete", oldID, name, nil)...)
+	}
+
+	if tags := expandSyntheticsMonitorTags(d.Get("tag"), d.Get("provider_tags")); len(tags) > 0 {
+		diags = append(diags, updateSyntheticsMonitorTags(ctx, d, providerConfig, nil, tags)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// The condition's query references the monitor ID, so point it at the
+	// new monitor.
+	diags = append(diags, syncSyntheticsMonitorAlert(ctx, d, client, selectAccountID(providerConfig, d))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, syncSyntheticsMonitorLocationRouting(ctx, d, providerConfig, client, selectAccountID(providerConfig, d))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The indicator belongs to the old monitor's entity, so replace it with
+	// one on the new monitor.
+	if o, _ := d.GetChange("slo"); syntheticsMonitorSLOID(o) != "" {
+		if err := deleteSyntheticsMonitorSLO(ctx, client, syntheticsMonitorSLOID(o)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Service level indicator of replaced synthetics monitor %s could not be deleted", oldID),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	diags = append(diags, syncSyntheticsMonitorSLO(ctx, d, client, selectAccountID(providerConfig, d), "")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if workloadID, ok := d.GetOk("workload_id"); ok {
+		accountID := selectAccountID(providerConfig, d)
+		if err := setSyntheticsMonitorWorkloadMembership(ctx, client, workloadID.(string), syntheticsMonitorGUID(accountID, oldID), false); err != nil {
+			log.Printf("[WARN] Could not remove New Relic Synthetics monitor %s from workload %s: %s", oldID, workloadID, err)
+		}
+		if err := setSyntheticsMonitorWorkloadMembership(ctx, client, workloadID.(string), syntheticsMonitorGUID(accountID, id), true); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return append(diags, resourceNewRelicSyntheticsMonitorRead(ctx, d, meta)...)
+}
+
+func resourceNewRelicSyntheticsMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	ctx, cancel := providerConfig.operationContext(ctx, operationDelete)
+	defer cancel()
+
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("read_only").(bool) {
+		log.Printf("[INFO] New Relic Synthetics monitor %s is read-only, removing it from state without deleting it", d.Id())
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting New Relic Synthetics monitor %s", d.Id())
+
+	if workloadID, ok := d.GetOk("workload_id"); ok {
+		if err := setSyntheticsMonitorWorkloadMembership(ctx, client, workloadID.(string), syntheticsMonitorGUID(selectAccountID(providerConfig, d), d.Id()), false); err != nil {
+			log.Printf("[WARN] Could not remove New Relic Synthetics monitor %s from workload %s: %s", d.Id(), workloadID, err)
+		}
+	}
+
+	if cfg := syntheticsMonitorAlertConfig(d.Get("alert")); cfg != nil {
+		if cfg["condition_id"].(string) != "" {
+			if err := deleteSyntheticsMonitorAlert(ctx, client, selectAccountID(providerConfig, d), cfg["condition_id"].(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err := syncSyntheticsMonitorAlertChannels(ctx, client, cfg, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := deleteSyntheticsMonitorLocationRouting(ctx, d, client, selectAccountID(providerConfig, d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if sliID := syntheticsMonitorSLOID(d.Get("slo")); sliID != "" {
+		if err := deleteSyntheticsMonitorSLO(ctx, client, sliID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = retrySyntheticsRateLimited(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.Synthetics.DeleteMonitorWithContext(ctx, d.Id())
+	})
+	if err != nil {
+		// The monitor is already gone (e.g. deleted in the UI, or by an
+		// earlier destroy that was interrupted), which is the desired end
+		// state.
+		if _, ok := err.(*errors.NotFound); ok {
+			log.Printf("[WARN] New Relic Synthetics monitor %s was already deleted", d.Id())
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	return recordSyntheticsMonitorOperation(providerConfig, "delete", d.Id(), d.Get("name").(string), nil)
 }
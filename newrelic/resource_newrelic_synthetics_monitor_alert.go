@@ -0,0 +1,475 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+)
+
+const (
+	syntheticsMonitorAlertSeverityCritical = "critical"
+	syntheticsMonitorAlertSeverityWarning  = "warning"
+)
+
+// syntheticsMonitorAlertViolationTimeLimitSeconds force-closes violations of
+// the monitor's inline alert condition after a day.
+const syntheticsMonitorAlertViolationTimeLimitSeconds = 86400
+
+func syntheticsMonitorAlertSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "A NRQL alert condition on the monitor's check duration, managed together with the monitor.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_id": {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "The ID of the alert policy the condition belongs to.",
+				},
+				"duration_ms": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The average check duration, in milliseconds, above which the condition opens a violation of the given severity.",
+				},
+				"severity": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      syntheticsMonitorAlertSeverityCritical,
+					ValidateFunc: validation.StringInSlice([]string{syntheticsMonitorAlertSeverityCritical, syntheticsMonitorAlertSeverityWarning}, false),
+					Description:  "The severity of violations above duration_ms: critical or warning. Warning requires critical_duration_ms.",
+				},
+				"critical_duration_ms": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "With a warning severity, the average check duration, in milliseconds, above which the condition opens a critical violation. Must be greater than duration_ms.",
+				},
+				"threshold_duration": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      300,
+					ValidateFunc: validation.All(validation.IntBetween(60, 86400), validation.IntDivisibleBy(60)),
+					Description:  "How long, in seconds, the duration must stay above duration_ms before a violation opens. Must be a multiple of 60.",
+				},
+				"aggregation_window": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      60,
+					ValidateFunc: validation.IntAtLeast(30),
+					Description:  "The duration, in seconds, of the windows check durations are aggregated over before being evaluated. threshold_duration must be a multiple of it.",
+				},
+				"aggregation_method": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      string(alerts.NrqlConditionAggregationMethodTypes.EventFlow),
+					ValidateFunc: validation.StringInSlice([]string{string(alerts.NrqlConditionAggregationMethodTypes.Cadence), string(alerts.NrqlConditionAggregationMethodTypes.EventFlow), string(alerts.NrqlConditionAggregationMethodTypes.EventTimer)}, false),
+					Description:  "When an aggregation window is considered complete: CADENCE, EVENT_FLOW or EVENT_TIMER.",
+				},
+				"notification_channel_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt},
+					Description: "The IDs of notification channels to associate with the policy. They are dissociated from it when removed from the list or when the alert block is removed.",
+				},
+				"runbook_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The runbook URL to display in notifications.",
+				},
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether the condition is enabled.",
+				},
+				"condition_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the NRQL alert condition.",
+				},
+			},
+		},
+	}
+}
+
+func syntheticsMonitorAlertQuery(monitorID string) string {
+	return fmt.Sprintf("SELECT average(duration) FROM SyntheticCheck WHERE monitorId = '%s'", monitorID)
+}
+
+// validateSyntheticsMonitorAlert checks the severity and aggregation of the
+// inline alert condition at plan time. New Relic requires every condition to
+// have a critical term, so a warning needs a higher critical threshold beside
+// it, and evaluates thresholds over whole aggregation windows.
+func validateSyntheticsMonitorAlert(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("alert") {
+		return nil
+	}
+
+	cfg := syntheticsMonitorAlertConfig(diff.Get("alert"))
+	if cfg == nil {
+		return nil
+	}
+
+	window := cfg["aggregation_window"].(int)
+	thresholdDuration := cfg["threshold_duration"].(int)
+	if window > 0 && thresholdDuration%window != 0 {
+		return fmt.Errorf("alert.0.threshold_duration (%d) must be a multiple of alert.0.aggregation_window (%d)", thresholdDuration, window)
+	}
+
+	duration := cfg["duration_ms"].(int)
+	critical := cfg["critical_duration_ms"].(int)
+
+	if cfg["severity"].(string) != syntheticsMonitorAlertSeverityWarning {
+		if critical != 0 {
+			return fmt.Errorf("alert.0.critical_duration_ms can only be set with a warning severity")
+		}
+		return nil
+	}
+
+	if critical == 0 {
+		return fmt.Errorf("alert.0.critical_duration_ms is required with a warning severity, since alert conditions need a critical threshold")
+	}
+
+	if critical <= duration {
+		return fmt.Errorf("alert.0.critical_duration_ms (%d) must be greater than the warning duration_ms (%d)", critical, duration)
+	}
+
+	return nil
+}
+
+func expandSyntheticsMonitorAlertTerm(priority alerts.NrqlConditionPriority, durationMs int, thresholdDuration int) alerts.NrqlConditionTerm {
+	threshold := float64(durationMs)
+
+	return alerts.NrqlConditionTerm{
+		Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+		Priority:             priority,
+		Threshold:            &threshold,
+		ThresholdDuration:    thresholdDuration,
+		ThresholdOccurrences: alerts.ThresholdOccurrences.All,
+	}
+}
+
+func expandSyntheticsMonitorAlertBase(monitorName string, cfg map[string]interface{}) (string, bool, []alerts.NrqlConditionTerm) {
+	thresholdDuration := cfg["threshold_duration"].(int)
+
+	var terms []alerts.NrqlConditionTerm
+	if cfg["severity"].(string) == syntheticsMonitorAlertSeverityWarning {
+		terms = []alerts.NrqlConditionTerm{
+			expandSyntheticsMonitorAlertTerm(alerts.NrqlConditionPriorities.Critical, cfg["critical_duration_ms"].(int), thresholdDuration),
+			expandSyntheticsMonitorAlertTerm(alerts.NrqlConditionPriorities.Warning, cfg["duration_ms"].(int), thresholdDuration),
+		}
+	} else {
+		terms = []alerts.NrqlConditionTerm{
+			expandSyntheticsMonitorAlertTerm(alerts.NrqlConditionPriorities.Critical, cfg["duration_ms"].(int), thresholdDuration),
+		}
+	}
+
+	return fmt.Sprintf("%s check duration", monitorName), cfg["enabled"].(bool), terms
+}
+
+func expandSyntheticsMonitorAlertCreateInput(monitorID string, monitorName string, cfg map[string]interface{}) alerts.NrqlConditionCreateInput {
+	name, enabled, terms := expandSyntheticsMonitorAlertBase(monitorName, cfg)
+	valueFunction := alerts.NrqlConditionValueFunctions.SingleValue
+
+	input := alerts.NrqlConditionCreateInput{ValueFunction: &valueFunction}
+	input.Name = name
+	input.Enabled = enabled
+	input.Type = alerts.NrqlConditionTypes.Static
+	input.Nrql = alerts.NrqlConditionCreateQuery{Query: syntheticsMonitorAlertQuery(monitorID)}
+	input.RunbookURL = cfg["runbook_url"].(string)
+	input.Terms = terms
+	input.ViolationTimeLimitSeconds = syntheticsMonitorAlertViolationTimeLimitSeconds
+
+	window, method := expandSyntheticsMonitorAlertAggregation(cfg)
+	input.Signal = &alerts.AlertsNrqlConditionCreateSignal{
+		AggregationWindow: window,
+		AggregationMethod: method,
+	}
+
+	return input
+}
+
+func expandSyntheticsMonitorAlertUpdateInput(monitorID string, monitorName string, cfg map[string]interface{}) alerts.NrqlConditionUpdateInput {
+	name, enabled, terms := expandSyntheticsMonitorAlertBase(monitorName, cfg)
+	valueFunction := alerts.NrqlConditionValueFunctions.SingleValue
+
+	input := alerts.NrqlConditionUpdateInput{ValueFunction: &valueFunction}
+	input.Name = name
+	input.Enabled = enabled
+	input.Type = alerts.NrqlConditionTypes.Static
+	input.Nrql = alerts.NrqlConditionUpdateQuery{Query: syntheticsMonitorAlertQuery(monitorID)}
+	input.RunbookURL = cfg["runbook_url"].(string)
+	input.Terms = terms
+	input.ViolationTimeLimitSeconds = syntheticsMonitorAlertViolationTimeLimitSeconds
+
+	window, method := expandSyntheticsMonitorAlertAggregation(cfg)
+	input.Signal = &alerts.AlertsNrqlConditionUpdateSignal{
+		AggregationWindow: window,
+		AggregationMethod: method,
+	}
+
+	return input
+}
+
+// expandSyntheticsMonitorAlertAggregation returns the condition's aggregation
+// window and method. Settings missing from older states are left to the
+// API's defaults.
+func expandSyntheticsMonitorAlertAggregation(cfg map[string]interface{}) (*int, *alerts.NrqlConditionAggregationMethod) {
+	var window *int
+	if w, ok := cfg["aggregation_window"].(int); ok && w > 0 {
+		window = &w
+	}
+
+	var method *alerts.NrqlConditionAggregationMethod
+	if m, ok := cfg["aggregation_method"].(string); ok && m != "" {
+		v := alerts.NrqlConditionAggregationMethod(m)
+		method = &v
+	}
+
+	return window, method
+}
+
+func syntheticsMonitorAlertConfig(v interface{}) map[string]interface{} {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	return l[0].(map[string]interface{})
+}
+
+// syncSyntheticsMonitorAlert reconciles the monitor's inline alert condition
+// with its configuration. A condition can't move between policies, so a new
+// policy_id replaces the condition.
+func syncSyntheticsMonitorAlert(ctx context.Context, d *schema.ResourceData, client *nr.NewRelic, accountID int) diag.Diagnostics {
+	o, n := d.GetChange("alert")
+	oldCfg := syntheticsMonitorAlertConfig(o)
+	newCfg := syntheticsMonitorAlertConfig(n)
+
+	conditionID := ""
+	if oldCfg != nil {
+		conditionID = oldCfg["condition_id"].(string)
+	}
+
+	if conditionID != "" && (newCfg == nil || oldCfg["policy_id"].(int) != newCfg["policy_id"].(int)) {
+		if err := deleteSyntheticsMonitorAlert(ctx, client, accountID, conditionID); err != nil {
+			return diag.FromErr(err)
+		}
+		conditionID = ""
+	}
+
+	if err := syncSyntheticsMonitorAlertChannels(ctx, client, oldCfg, newCfg); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if newCfg == nil {
+		return nil
+	}
+
+	monitorName := d.Get("name").(string)
+
+	if conditionID == "" {
+		log.Printf("[INFO] Creating alert condition for New Relic Synthetics monitor %s", d.Id())
+
+		policyID := strconv.Itoa(newCfg["policy_id"].(int))
+		condition, err := client.Alerts.CreateNrqlConditionStaticMutationWithContext(ctx, accountID, policyID, expandSyntheticsMonitorAlertCreateInput(d.Id(), monitorName, newCfg))
+		if err != nil {
+			return diag.Errorf("error creating alert condition for synthetics monitor %s: %s", d.Id(), err)
+		}
+		conditionID = condition.ID
+	} else {
+		log.Printf("[INFO] Updating alert condition %s of New Relic Synthetics monitor %s", conditionID, d.Id())
+
+		if _, err := client.Alerts.UpdateNrqlConditionStaticMutationWithContext(ctx, accountID, conditionID, expandSyntheticsMonitorAlertUpdateInput(d.Id(), monitorName, newCfg)); err != nil {
+			return diag.Errorf("error updating alert condition %s of synthetics monitor %s: %s", conditionID, d.Id(), err)
+		}
+	}
+
+	newCfg["condition_id"] = conditionID
+	_ = d.Set("alert", []interface{}{newCfg})
+
+	return nil
+}
+
+// readSyntheticsMonitorAlert refreshes the inline alert condition. A condition
+// deleted outside of Terraform is dropped from state so it gets recreated.
+func readSyntheticsMonitorAlert(ctx context.Context, d *schema.ResourceData, client *nr.NewRelic, accountID int) error {
+	cfg := syntheticsMonitorAlertConfig(d.Get("alert"))
+	if cfg == nil || cfg["condition_id"].(string) == "" {
+		return nil
+	}
+
+	condition, err := client.Alerts.GetNrqlConditionQueryWithContext(ctx, accountID, cfg["condition_id"].(string))
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return d.Set("alert", nil)
+		}
+		return err
+	}
+
+	if policyID, err := strconv.Atoi(condition.PolicyID); err == nil {
+		cfg["policy_id"] = policyID
+	}
+	cfg["runbook_url"] = condition.RunbookURL
+	cfg["enabled"] = condition.Enabled
+
+	if condition.Signal != nil {
+		if condition.Signal.AggregationWindow != nil {
+			cfg["aggregation_window"] = *condition.Signal.AggregationWindow
+		}
+		if condition.Signal.AggregationMethod != nil {
+			cfg["aggregation_method"] = string(*condition.Signal.AggregationMethod)
+		}
+	}
+
+	channels, err := readSyntheticsMonitorAlertChannels(ctx, client, cfg)
+	if err != nil {
+		return err
+	}
+	cfg["notification_channel_ids"] = channels
+
+	// duration_ms holds the warning threshold when there is one, and the
+	// critical threshold otherwise.
+	cfg["severity"] = syntheticsMonitorAlertSeverityCritical
+	cfg["critical_duration_ms"] = 0
+	for _, term := range condition.Terms {
+		if term.Priority == alerts.NrqlConditionPriorities.Warning && term.Threshold != nil {
+			cfg["severity"] = syntheticsMonitorAlertSeverityWarning
+		}
+	}
+
+	for _, term := range condition.Terms {
+		if term.Threshold == nil {
+			continue
+		}
+
+		switch {
+		case term.Priority == alerts.NrqlConditionPriorities.Critical && cfg["severity"] == syntheticsMonitorAlertSeverityWarning:
+			cfg["critical_duration_ms"] = int(*term.Threshold)
+		case term.Priority == alerts.NrqlConditionPriorities.Critical, term.Priority == alerts.NrqlConditionPriorities.Warning:
+			cfg["duration_ms"] = int(*term.Threshold)
+			cfg["threshold_duration"] = term.ThresholdDuration
+		}
+	}
+
+	return d.Set("alert", []interface{}{cfg})
+}
+
+// syntheticsMonitorAlertChannels returns the policy and notification channels
+// of an alert block, or no channels when there is no block.
+func syntheticsMonitorAlertChannels(cfg map[string]interface{}) (int, []int) {
+	if cfg == nil {
+		return 0, nil
+	}
+
+	channels, _ := cfg["notification_channel_ids"].(*schema.Set)
+	if channels == nil {
+		return cfg["policy_id"].(int), nil
+	}
+
+	ids := expandIntSet(channels)
+	sortIntegerSlice(ids)
+
+	return cfg["policy_id"].(int), ids
+}
+
+// syncSyntheticsMonitorAlertChannels associates the alert block's policy with
+// its notification channels, and dissociates the channels removed from the
+// block, or all of the old policy's channels when the policy changed or the
+// block was removed. The channels are checked to exist before associating
+// them, since the API silently ignores unknown IDs.
+func syncSyntheticsMonitorAlertChannels(ctx context.Context, client *nr.NewRelic, oldCfg map[string]interface{}, newCfg map[string]interface{}) error {
+	oldPolicyID, oldChannels := syntheticsMonitorAlertChannels(oldCfg)
+	newPolicyID, newChannels := syntheticsMonitorAlertChannels(newCfg)
+
+	var added []int
+	for _, id := range newChannels {
+		if oldPolicyID != newPolicyID || !intSliceContains(oldChannels, id) {
+			added = append(added, id)
+		}
+	}
+
+	if len(added) > 0 {
+		channels, err := client.Alerts.ListChannelsWithContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range added {
+			if findChannel(channels, id) == nil {
+				return fmt.Errorf("alert.0.notification_channel_ids: notification channel %d does not exist", id)
+			}
+		}
+
+		log.Printf("[INFO] Associating notification channels %v with alert policy %d", added, newPolicyID)
+
+		if _, err := client.Alerts.UpdatePolicyChannelsWithContext(ctx, newPolicyID, added); err != nil {
+			return fmt.Errorf("error associating notification channels with alert policy %d: %w", newPolicyID, err)
+		}
+	}
+
+	for _, id := range oldChannels {
+		if oldPolicyID == newPolicyID && intSliceContains(newChannels, id) {
+			continue
+		}
+
+		log.Printf("[INFO] Dissociating notification channel %d from alert policy %d", id, oldPolicyID)
+
+		if _, err := client.Alerts.DeletePolicyChannelWithContext(ctx, oldPolicyID, id); err != nil {
+			if _, ok := err.(*errors.NotFound); ok {
+				continue
+			}
+			return fmt.Errorf("error dissociating notification channel %d from alert policy %d: %w", id, oldPolicyID, err)
+		}
+	}
+
+	return nil
+}
+
+// readSyntheticsMonitorAlertChannels returns the alert block's notification
+// channels still associated with its policy, so channels dissociated or
+// deleted outside of Terraform get associated again.
+func readSyntheticsMonitorAlertChannels(ctx context.Context, client *nr.NewRelic, cfg map[string]interface{}) ([]int, error) {
+	policyID, ids := syntheticsMonitorAlertChannels(cfg)
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	channels, err := client.Alerts.ListChannelsWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	associated := []int{}
+	for _, id := range ids {
+		if channel := findChannel(channels, id); channel != nil && intSliceContains(channel.Links.PolicyIDs, policyID) {
+			associated = append(associated, id)
+		}
+	}
+
+	return associated, nil
+}
+
+func deleteSyntheticsMonitorAlert(ctx context.Context, client *nr.NewRelic, accountID int, conditionID string) error {
+	log.Printf("[INFO] Deleting alert condition %s", conditionID)
+
+	if _, err := client.Alerts.DeleteNrqlConditionMutationWithContext(ctx, accountID, conditionID); err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return nil
+		}
+		return fmt.Errorf("error deleting alert condition %s: %w", conditionID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package newrelic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+)
+
+// resourceNewRelicSyntheticsMonitorCleanup deletes every monitor matching a
+// tag filter when it is destroyed, for monitors created outside Terraform by
+// ephemeral environments. Creating it changes nothing.
+func resourceNewRelicSyntheticsMonitorCleanup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicSyntheticsMonitorCleanupCreate,
+		ReadContext:   resourceNewRelicSyntheticsMonitorCleanupRead,
+		UpdateContext: resourceNewRelicSyntheticsMonitorCleanupUpdate,
+		DeleteContext: resourceNewRelicSyntheticsMonitorCleanupDelete,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The New Relic account ID of the monitors.",
+			},
+			"tag": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Delete the monitors having all of these tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The tag key.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The tag value.",
+						},
+					},
+				},
+			},
+			"confirm": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Must be true for the matching monitors to be deleted on destroy. Destroying fails otherwise.",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only report the monitors that would be deleted on destroy, without deleting them.",
+			},
+			"monitor_ids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The IDs of the monitors currently matching the tags, sorted.",
+			},
+		},
+	}
+}
+
+func resourceNewRelicSyntheticsMonitorCleanupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accountID := selectAccountID(meta.(*ProviderConfig), d)
+	query := syntheticsMonitorTagQuery(d.Get("tag").([]interface{}))
+
+	sum := sha256.Sum256([]byte(strconv.Itoa(accountID) + ":" + query))
+	d.SetId(hex.EncodeToString(sum[:]))
+	_ = d.Set("account_id", accountID)
+
+	return resourceNewRelicSyntheticsMonitorCleanupRead(ctx, d, meta)
+}
+
+func resourceNewRelicSyntheticsMonitorCleanupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Reading New Relic Synthetics monitors to clean up")
+
+	ids, err := listSyntheticsMonitorCleanupIDs(ctx, meta.(*ProviderConfig), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(d.Set("monitor_ids", ids))
+}
+
+// Update only changes confirm and dry_run, which take effect on destroy.
+func resourceNewRelicSyntheticsMonitorCleanupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNewRelicSyntheticsMonitorCleanupRead(ctx, d, meta)
+}
+
+// Delete lists the matching monitors again and deletes each of them. Every
+// monitor is attempted and each failure is reported separately; the resource
+// stays in state when any fail, so destroying again retries the rest. A dry
+// run only reports the monitors, and also keeps the resource in state.
+func resourceNewRelicSyntheticsMonitorCleanupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	ids, err := listSyntheticsMonitorCleanupIDs(ctx, meta.(*ProviderConfig), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A dry run fails the destroy, as a successful one would drop the
+	// resource from state without deleting anything.
+	if d.Get("dry_run").(bool) {
+		log.Printf("[INFO] Dry run: %d New Relic Synthetics monitors would be deleted", len(ids))
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("dry run: %d synthetics monitors would be deleted, set dry_run = false to delete them", len(ids)),
+			Detail:   strings.Join(ids, "\n"),
+		}}
+	}
+
+	if !d.Get("confirm").(bool) {
+		return diag.Errorf("refusing to delete %d synthetics monitors: set confirm = true to delete them", len(ids))
+	}
+
+	var diags diag.Diagnostics
+
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting New Relic Synthetics monitor %s", id)
+
+		err := retrySyntheticsRateLimited(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+			return client.Synthetics.DeleteMonitorWithContext(ctx, id)
+		})
+		if err != nil {
+			if _, ok := err.(*errors.NotFound); ok {
+				continue
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("error deleting synthetics monitor %s", id),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return diags
+}
+
+// listSyntheticsMonitorCleanupIDs returns the sorted IDs of the account's
+// monitors having all of the resource's tags.
+func listSyntheticsMonitorCleanupIDs(ctx context.Context, providerConfig *ProviderConfig, d *schema.ResourceData) ([]string, error) {
+	accountID := d.Get("account_id").(int)
+
+	ctx, cancel := providerConfig.operationContext(ctx, operationSearch)
+	defer cancel()
+
+	entities, err := searchEntitiesWithTags(ctx, providerConfig.NewClient, syntheticsMonitorTagQuery(d.Get("tag").([]interface{})))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, e := range entities {
+		if e.AccountID != accountID {
+			continue
+		}
+
+		id, err := syntheticsMonitorIDFromGUID(string(e.GUID))
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids, nil
+}
@@ -0,0 +1,79 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func testSyntheticsMonitorCleanupProviderConfig(t *testing.T, deleted *[]string) *ProviderConfig {
+	return testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			*deleted = append(*deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, `{"data":{"actor":{"entitySearch":{"results":{"entities":[
+			{"accountId":123,"guid":%q},
+			{"accountId":123,"guid":%q},
+			{"accountId":456,"guid":%q}
+		]}}}}}`,
+			syntheticsMonitorGUID(123, "def-456"),
+			syntheticsMonitorGUID(123, "abc-123"),
+			syntheticsMonitorGUID(456, "ghi-789"))
+	})
+}
+
+func testSyntheticsMonitorCleanupResourceData(t *testing.T, confirm bool, dryRun bool) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitorCleanup().Schema, map[string]interface{}{
+		"account_id": 123,
+		"tag": []interface{}{
+			map[string]interface{}{"key": "environment", "value": "preview-1234"},
+		},
+		"confirm": confirm,
+		"dry_run": dryRun,
+	})
+	d.SetId("cleanup")
+
+	return d
+}
+
+func TestResourceNewRelicSyntheticsMonitorCleanupRead(t *testing.T) {
+	var deleted []string
+	providerConfig := testSyntheticsMonitorCleanupProviderConfig(t, &deleted)
+
+	d := testSyntheticsMonitorCleanupResourceData(t, false, false)
+
+	diags := resourceNewRelicSyntheticsMonitorCleanupRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []interface{}{"abc-123", "def-456"}, d.Get("monitor_ids"))
+	require.Empty(t, deleted)
+}
+
+func TestResourceNewRelicSyntheticsMonitorCleanupDelete(t *testing.T) {
+	var deleted []string
+	providerConfig := testSyntheticsMonitorCleanupProviderConfig(t, &deleted)
+
+	diags := resourceNewRelicSyntheticsMonitorCleanupDelete(context.Background(), testSyntheticsMonitorCleanupResourceData(t, false, false), providerConfig)
+	require.True(t, diags.HasError())
+	require.Empty(t, deleted)
+
+	// A dry run fails, so the resource stays in state.
+	diags = resourceNewRelicSyntheticsMonitorCleanupDelete(context.Background(), testSyntheticsMonitorCleanupResourceData(t, true, true), providerConfig)
+	require.True(t, diags.HasError())
+	require.Len(t, diags, 1)
+	require.Equal(t, "abc-123\ndef-456", diags[0].Detail)
+	require.Empty(t, deleted)
+
+	diags = resourceNewRelicSyntheticsMonitorCleanupDelete(context.Background(), testSyntheticsMonitorCleanupResourceData(t, true, false), providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []string{"/v4/monitors/abc-123", "/v4/monitors/def-456"}, deleted)
+}
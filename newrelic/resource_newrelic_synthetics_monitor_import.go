@@ -0,0 +1,192 @@
+package newrelic
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+)
+
+// syntheticsMonitorLegacyPathRegexp matches the monitor pages of the legacy
+// Synthetics UI, e.g. /accounts/123/monitors/<monitor ID>.
+var syntheticsMonitorLegacyPathRegexp = regexp.MustCompile(`^/accounts/([0-9]+)/monitors/([0-9a-fA-F-]+)`)
+
+// syntheticsMonitorImportAlertConditionsSuffix is appended to the import ID
+// to also look up the alert conditions referencing the monitor.
+const syntheticsMonitorImportAlertConditionsSuffix = ":with_alert_conditions"
+
+// importSyntheticsMonitor imports a monitor by its ID, its entity GUID, or the
+// URL of its page in the New Relic UI. With the :with_alert_conditions suffix, the
+// synthetics and multi-location synthetics alert conditions referencing the
+// monitor are exported as alert_condition_ids, so they can be imported next.
+func importSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	withAlertConditions := strings.HasSuffix(d.Id(), syntheticsMonitorImportAlertConditionsSuffix)
+	if withAlertConditions {
+		d.SetId(strings.TrimSuffix(d.Id(), syntheticsMonitorImportAlertConditionsSuffix))
+	}
+
+	if strings.HasPrefix(d.Id(), "https://") || strings.HasPrefix(d.Id(), "http://") {
+		if err := importSyntheticsMonitorPermalink(ctx, d, meta); err != nil {
+			return nil, err
+		}
+	}
+
+	if syntheticsMonitorAPISource(d.Id()) == "NERDGRAPH" {
+		monitorID, err := syntheticsMonitorIDFromGUID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		_ = d.Set("api_source", "NERDGRAPH")
+		d.SetId(monitorID)
+	}
+
+	if withAlertConditions {
+		if err := importSyntheticsMonitorAlertConditionIDs(ctx, d, meta); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importSyntheticsMonitorPermalink sets the ID, and the account ID when the
+// URL has one, of the monitor whose page is at the URL held by the import ID.
+func importSyntheticsMonitorPermalink(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	permalink := d.Id()
+
+	accountID, monitorID, err := parseSyntheticsMonitorPermalink(permalink)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Importing New Relic Synthetics monitor %s from %s", monitorID, permalink)
+
+	providerConfig := meta.(*ProviderConfig)
+	d.SetId(monitorID)
+	if accountID != 0 {
+		_ = d.Set("account_id", accountID)
+	}
+
+	client, err := selectClient(providerConfig, d)
+	if err != nil {
+		return err
+	}
+
+	if _, err := getSyntheticsMonitor(ctx, providerConfig, client, monitorID); err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return fmt.Errorf("synthetics monitor %s from %s not found", monitorID, permalink)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// importSyntheticsMonitorAlertConditionIDs exports the IDs of the alert
+// conditions referencing the monitor as alert_condition_ids. They are kept as
+// of the import unless fetch_alert_conditions refreshes them on each read.
+func importSyntheticsMonitorAlertConditionIDs(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client, err := selectClient(meta.(*ProviderConfig), d)
+	if err != nil {
+		return err
+	}
+
+	ids, err := listSyntheticsMonitorAlertConditionIDs(ctx, client, d.Id())
+	if err != nil {
+		return fmt.Errorf("error looking up the alert conditions of synthetics monitor %s: %s", d.Id(), err)
+	}
+
+	if len(ids) == 0 {
+		log.Printf("[INFO] No alert condition references New Relic Synthetics monitor %s", d.Id())
+	} else {
+		log.Printf("[INFO] Found alert conditions %s referencing New Relic Synthetics monitor %s", strings.Join(ids, ", "), d.Id())
+	}
+
+	return d.Set("alert_condition_ids", ids)
+}
+
+// parseSyntheticsMonitorPermalink extracts the monitor ID, and the account ID
+// when the URL has one, from the URL of a monitor's page in the New Relic UI.
+// It understands legacy Synthetics URLs and New Relic One URLs carrying the
+// monitor's entity GUID in their path, in a query parameter, or in the
+// base64-encoded JSON of a query parameter such as `pane`.
+func parseSyntheticsMonitorPermalink(permalink string) (int, string, error) {
+	u, err := url.Parse(permalink)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid synthetics monitor URL %q: %w", permalink, err)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host != "newrelic.com" && !strings.HasSuffix(host, ".newrelic.com") {
+		return 0, "", fmt.Errorf("%q is not a New Relic URL", permalink)
+	}
+
+	if m := syntheticsMonitorLegacyPathRegexp.FindStringSubmatch(u.Path); m != nil {
+		accountID, err := strconv.Atoi(m[1])
+		if err != nil {
+			return 0, "", fmt.Errorf("invalid account ID in synthetics monitor URL %q: %w", permalink, err)
+		}
+
+		return accountID, m[2], nil
+	}
+
+	candidates := strings.Split(u.Path, "/")
+	for _, values := range u.Query() {
+		candidates = append(candidates, values...)
+	}
+
+	for _, c := range candidates {
+		if guid := findSyntheticsMonitorGUID(c); guid != "" {
+			monitorID, err := syntheticsMonitorIDFromGUID(guid)
+			if err != nil {
+				return 0, "", err
+			}
+
+			accountID, err := entityGUIDAccountID(guid)
+			if err != nil {
+				return 0, "", err
+			}
+
+			return accountID, monitorID, nil
+		}
+	}
+
+	return 0, "", fmt.Errorf("%q does not match a known synthetics monitor URL pattern: expected a /accounts/<account ID>/monitors/<monitor ID> path or a monitor entity GUID", permalink)
+}
+
+// findSyntheticsMonitorGUID returns s if it is a monitor entity GUID, or the
+// monitor GUID held in s when it is base64-encoded JSON with an `entityGuid`
+// field. It returns "" otherwise.
+func findSyntheticsMonitorGUID(s string) string {
+	raw, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return ""
+	}
+
+	if _, err := syntheticsMonitorIDFromGUID(s); err == nil {
+		return s
+	}
+
+	var pane struct {
+		EntityGUID string `json:"entityGuid"`
+	}
+	if err := json.Unmarshal(raw, &pane); err != nil || pane.EntityGUID == "" {
+		return ""
+	}
+
+	if _, err := syntheticsMonitorIDFromGUID(pane.EntityGUID); err != nil {
+		return ""
+	}
+
+	return pane.EntityGUID
+}
@@ -0,0 +1,123 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSyntheticsMonitorPermalink(t *testing.T) {
+	guid := string(syntheticsMonitorGUID(456, "abc-123"))
+	pane := base64.StdEncoding.EncodeToString([]byte(`{"nerdletId":"synthetics-nerdlets.monitor-overview","entityGuid":"` + guid + `"}`))
+
+	cases := map[string]struct {
+		accountID int
+		monitorID string
+	}{
+		"https://synthetics.newrelic.com/accounts/456/monitors/abc-123":                                     {456, "abc-123"},
+		"https://synthetics.eu.newrelic.com/accounts/456/monitors/abc-123/results":                          {456, "abc-123"},
+		"https://one.newrelic.com/redirect/entity/" + guid:                                                  {456, "abc-123"},
+		"https://one.eu.newrelic.com/nr1-core/synthetics/" + guid + "?duration=1800000":                     {456, "abc-123"},
+		"https://one.newrelic.com/launcher/synthetics-nerdlets.home-launcher?pane=" + url.QueryEscape(pane): {456, "abc-123"},
+	}
+
+	for permalink, want := range cases {
+		accountID, monitorID, err := parseSyntheticsMonitorPermalink(permalink)
+		require.NoError(t, err, permalink)
+		require.Equal(t, want.accountID, accountID, permalink)
+		require.Equal(t, want.monitorID, monitorID, permalink)
+	}
+
+	for _, permalink := range []string{
+		"https://example.com/accounts/456/monitors/abc-123",
+		"https://one.newrelic.com/launcher/dashboards.launcher",
+		"https://one.newrelic.com/redirect/entity/" + string(syntheticsMonitorGUID(456, "abc-123"))[:10],
+	} {
+		_, _, err := parseSyntheticsMonitorPermalink(permalink)
+		require.Error(t, err, permalink)
+	}
+}
+
+func TestImportSyntheticsMonitor(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/monitors/abc-123" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":5,"uri":"https://example.com","locations":["AWS_US_EAST_1"],"status":"ENABLED"}`))
+	})
+
+	r := resourceNewRelicSyntheticsMonitor()
+
+	d := r.TestResourceData()
+	d.SetId("https://synthetics.newrelic.com/accounts/123/monitors/abc-123")
+
+	imported, err := importSyntheticsMonitor(context.Background(), d, providerConfig)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, "abc-123", imported[0].Id())
+	require.Equal(t, 123, imported[0].Get("account_id"))
+
+	// Plain IDs are imported as they are.
+	d = r.TestResourceData()
+	d.SetId("def-456")
+
+	imported, err = importSyntheticsMonitor(context.Background(), d, providerConfig)
+	require.NoError(t, err)
+	require.Equal(t, "def-456", imported[0].Id())
+
+	// Entity GUIDs are imported as the monitor ID they encode.
+	d = r.TestResourceData()
+	d.SetId(string(syntheticsMonitorGUID(123, "def-456")))
+
+	imported, err = importSyntheticsMonitor(context.Background(), d, providerConfig)
+	require.NoError(t, err)
+	require.Equal(t, "def-456", imported[0].Id())
+	require.Equal(t, "NERDGRAPH", imported[0].Get("api_source"))
+
+	d = r.TestResourceData()
+	d.SetId("https://synthetics.newrelic.com/accounts/123/monitors/def-456")
+
+	_, err = importSyntheticsMonitor(context.Background(), d, providerConfig)
+	require.EqualError(t, err, "synthetics monitor def-456 from https://synthetics.newrelic.com/accounts/123/monitors/def-456 not found")
+}
+
+func TestImportSyntheticsMonitor_WithAlertConditions(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/alerts_policies.json":
+			_, _ = w.Write([]byte(`{"policies":[{"id":1,"name":"foo"}]}`))
+		case "/alerts_synthetics_conditions.json":
+			_, _ = w.Write([]byte(`{"synthetics_conditions":[{"id":10,"monitor_id":"abc-123"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"location_failure_conditions":[]}`))
+		}
+	})
+
+	r := resourceNewRelicSyntheticsMonitor()
+
+	d := r.TestResourceData()
+	d.SetId("abc-123:with_alert_conditions")
+
+	imported, err := importSyntheticsMonitor(context.Background(), d, providerConfig)
+	require.NoError(t, err)
+	require.Equal(t, "abc-123", imported[0].Id())
+	require.Equal(t, []interface{}{"1:10"}, imported[0].Get("alert_condition_ids"))
+
+	// Monitors no condition references are imported with no IDs.
+	d = r.TestResourceData()
+	d.SetId("def-456:with_alert_conditions")
+
+	imported, err = importSyntheticsMonitor(context.Background(), d, providerConfig)
+	require.NoError(t, err)
+	require.Equal(t, "def-456", imported[0].Id())
+	require.Empty(t, imported[0].Get("alert_condition_ids"))
+}
@@ -0,0 +1,294 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+)
+
+// syntheticsMonitorLocationRoutingThresholdDuration is the window, in
+// seconds, in which a failed check at one of a group's locations opens a
+// violation. It spans several checks of all but the least frequent monitors.
+const syntheticsMonitorLocationRoutingThresholdDuration = 900
+
+func syntheticsMonitorLocationRoutingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Routes check failures at the locations of a provider location group to an alert policy, through a NRQL alert condition managed together with the monitor.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"location_group": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+					Description:  "The name of a synthetics_location_group of the provider configuration.",
+				},
+				"policy_id": {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "The ID of the alert policy that failures at the group's locations are routed to.",
+				},
+				"condition_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the NRQL alert condition.",
+				},
+			},
+		},
+	}
+}
+
+// validateSyntheticsMonitorLocationRouting checks at plan time that each
+// location_routing block names a distinct location group of the provider.
+func validateSyntheticsMonitorLocationRouting(diff *schema.ResourceDiff, providerConfig *ProviderConfig) error {
+	if !diff.NewValueKnown("location_routing") {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	for _, cfg := range syntheticsMonitorLocationRoutingConfigs(diff.Get("location_routing")) {
+		group := cfg["location_group"].(string)
+		if seen[group] {
+			return fmt.Errorf("location_routing: location group %q is routed more than once", group)
+		}
+		seen[group] = true
+
+		if _, err := syntheticsLocationGroupLocations(providerConfig, group); err != nil {
+			return fmt.Errorf("location_routing: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// syntheticsMonitorLocationRoutingQuery counts the monitor's failed checks at
+// the given locations. Multi-location synthetics conditions can't be scoped
+// to a subset of a monitor's locations, so routing uses NRQL conditions.
+func syntheticsMonitorLocationRoutingQuery(monitorID string, locations []string) string {
+	quoted := make([]string, len(locations))
+	for i, l := range locations {
+		quoted[i] = "'" + strings.ReplaceAll(l, "'", "\\'") + "'"
+	}
+
+	return fmt.Sprintf("SELECT count(*) FROM SyntheticCheck WHERE monitorId = '%s' AND result = 'FAILED' AND location IN (%s)", monitorID, strings.Join(quoted, ", "))
+}
+
+func expandSyntheticsMonitorLocationRoutingBase(monitorName string, group string) (string, []alerts.NrqlConditionTerm) {
+	threshold := float64(0)
+
+	terms := []alerts.NrqlConditionTerm{{
+		Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+		Priority:             alerts.NrqlConditionPriorities.Critical,
+		Threshold:            &threshold,
+		ThresholdDuration:    syntheticsMonitorLocationRoutingThresholdDuration,
+		ThresholdOccurrences: alerts.ThresholdOccurrences.AtLeastOnce,
+	}}
+
+	return fmt.Sprintf("%s failures in %s", monitorName, group), terms
+}
+
+func expandSyntheticsMonitorLocationRoutingCreateInput(monitorID string, monitorName string, group string, locations []string) alerts.NrqlConditionCreateInput {
+	name, terms := expandSyntheticsMonitorLocationRoutingBase(monitorName, group)
+	valueFunction := alerts.NrqlConditionValueFunctions.SingleValue
+
+	input := alerts.NrqlConditionCreateInput{ValueFunction: &valueFunction}
+	input.Name = name
+	input.Enabled = true
+	input.Type = alerts.NrqlConditionTypes.Static
+	input.Nrql = alerts.NrqlConditionCreateQuery{Query: syntheticsMonitorLocationRoutingQuery(monitorID, locations)}
+	input.Terms = terms
+	input.ViolationTimeLimitSeconds = syntheticsMonitorAlertViolationTimeLimitSeconds
+
+	return input
+}
+
+func expandSyntheticsMonitorLocationRoutingUpdateInput(monitorID string, monitorName string, group string, locations []string) alerts.NrqlConditionUpdateInput {
+	name, terms := expandSyntheticsMonitorLocationRoutingBase(monitorName, group)
+	valueFunction := alerts.NrqlConditionValueFunctions.SingleValue
+
+	input := alerts.NrqlConditionUpdateInput{ValueFunction: &valueFunction}
+	input.Name = name
+	input.Enabled = true
+	input.Type = alerts.NrqlConditionTypes.Static
+	input.Nrql = alerts.NrqlConditionUpdateQuery{Query: syntheticsMonitorLocationRoutingQuery(monitorID, locations)}
+	input.Terms = terms
+	input.ViolationTimeLimitSeconds = syntheticsMonitorAlertViolationTimeLimitSeconds
+
+	return input
+}
+
+func syntheticsMonitorLocationRoutingConfigs(v interface{}) []map[string]interface{} {
+	l, _ := v.([]interface{})
+
+	configs := make([]map[string]interface{}, 0, len(l))
+	for _, c := range l {
+		if c != nil {
+			configs = append(configs, c.(map[string]interface{}))
+		}
+	}
+
+	return configs
+}
+
+// syncSyntheticsMonitorLocationRouting reconciles the monitor's routing
+// conditions with its location_routing blocks, matched by location group.
+// Conditions of removed groups are deleted, and a group routed to another
+// policy gets a new condition, since a condition can't move between policies.
+// On failure, the conditions synced so far and the existing ones not yet
+// reached are kept in state, so the next apply doesn't duplicate them.
+func syncSyntheticsMonitorLocationRouting(ctx context.Context, d *schema.ResourceData, providerConfig *ProviderConfig, client *nr.NewRelic, accountID int) diag.Diagnostics {
+	o, n := d.GetChange("location_routing")
+
+	existing := map[string]map[string]interface{}{}
+	for _, cfg := range syntheticsMonitorLocationRoutingConfigs(o) {
+		existing[cfg["location_group"].(string)] = cfg
+	}
+
+	newCfgs := syntheticsMonitorLocationRoutingConfigs(n)
+	wanted := map[string]int{}
+	for _, cfg := range newCfgs {
+		wanted[cfg["location_group"].(string)] = cfg["policy_id"].(int)
+	}
+
+	routes := make([]interface{}, 0, len(newCfgs))
+	synced := map[string]bool{}
+
+	fail := func(diags diag.Diagnostics) diag.Diagnostics {
+		groups := make([]string, 0, len(existing))
+		for group, cfg := range existing {
+			if !synced[group] && cfg["condition_id"].(string) != "" {
+				groups = append(groups, group)
+			}
+		}
+		sort.Strings(groups)
+
+		for _, group := range groups {
+			routes = append(routes, existing[group])
+		}
+		_ = d.Set("location_routing", routes)
+
+		return diags
+	}
+
+	for group, cfg := range existing {
+		conditionID := cfg["condition_id"].(string)
+		if conditionID == "" {
+			continue
+		}
+
+		if policyID, ok := wanted[group]; ok && policyID == cfg["policy_id"].(int) {
+			continue
+		}
+
+		if err := deleteSyntheticsMonitorAlert(ctx, client, accountID, conditionID); err != nil {
+			return fail(diag.FromErr(err))
+		}
+		delete(existing, group)
+	}
+
+	monitorName := d.Get("name").(string)
+
+	for _, cfg := range newCfgs {
+		group := cfg["location_group"].(string)
+		policyID := cfg["policy_id"].(int)
+
+		locations, err := syntheticsLocationGroupLocations(providerConfig, group)
+		if err != nil {
+			return fail(diag.FromErr(err))
+		}
+
+		conditionID := ""
+		if old, ok := existing[group]; ok {
+			conditionID = old["condition_id"].(string)
+		}
+
+		if conditionID == "" {
+			if _, err := client.Alerts.QueryPolicyWithContext(ctx, accountID, strconv.Itoa(policyID)); err != nil {
+				return fail(diag.Errorf("error looking up alert policy %d of location_routing %q: %s", policyID, group, err))
+			}
+
+			log.Printf("[INFO] Creating alert condition routing %s failures of New Relic Synthetics monitor %s", group, d.Id())
+
+			condition, err := client.Alerts.CreateNrqlConditionStaticMutationWithContext(ctx, accountID, strconv.Itoa(policyID), expandSyntheticsMonitorLocationRoutingCreateInput(d.Id(), monitorName, group, locations))
+			if err != nil {
+				return fail(diag.Errorf("error creating alert condition for location_routing %q of synthetics monitor %s: %s", group, d.Id(), err))
+			}
+			conditionID = condition.ID
+		} else {
+			log.Printf("[INFO] Updating alert condition %s of New Relic Synthetics monitor %s", conditionID, d.Id())
+
+			if _, err := client.Alerts.UpdateNrqlConditionStaticMutationWithContext(ctx, accountID, conditionID, expandSyntheticsMonitorLocationRoutingUpdateInput(d.Id(), monitorName, group, locations)); err != nil {
+				return fail(diag.Errorf("error updating alert condition %s for location_routing %q of synthetics monitor %s: %s", conditionID, group, d.Id(), err))
+			}
+		}
+
+		routes = append(routes, map[string]interface{}{
+			"location_group": group,
+			"policy_id":      policyID,
+			"condition_id":   conditionID,
+		})
+		synced[group] = true
+	}
+
+	_ = d.Set("location_routing", routes)
+
+	return nil
+}
+
+// readSyntheticsMonitorLocationRouting refreshes the routing conditions.
+// Conditions deleted outside of Terraform are dropped from state so they get
+// recreated.
+func readSyntheticsMonitorLocationRouting(ctx context.Context, d *schema.ResourceData, client *nr.NewRelic, accountID int) error {
+	cfgs := syntheticsMonitorLocationRoutingConfigs(d.Get("location_routing"))
+	if len(cfgs) == 0 {
+		return nil
+	}
+
+	routes := make([]interface{}, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		conditionID := cfg["condition_id"].(string)
+		if conditionID == "" {
+			continue
+		}
+
+		condition, err := client.Alerts.GetNrqlConditionQueryWithContext(ctx, accountID, conditionID)
+		if err != nil {
+			if _, ok := err.(*errors.NotFound); ok {
+				continue
+			}
+			return err
+		}
+
+		if policyID, err := strconv.Atoi(condition.PolicyID); err == nil {
+			cfg["policy_id"] = policyID
+		}
+		routes = append(routes, cfg)
+	}
+
+	return d.Set("location_routing", routes)
+}
+
+// deleteSyntheticsMonitorLocationRouting deletes the monitor's routing
+// conditions.
+func deleteSyntheticsMonitorLocationRouting(ctx context.Context, d *schema.ResourceData, client *nr.NewRelic, accountID int) error {
+	for _, cfg := range syntheticsMonitorLocationRoutingConfigs(d.Get("location_routing")) {
+		if conditionID := cfg["condition_id"].(string); conditionID != "" {
+			if err := deleteSyntheticsMonitorAlert(ctx, client, accountID, conditionID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsMonitorLocationRoutingQuery(t *testing.T) {
+	require.Equal(t,
+		"SELECT count(*) FROM SyntheticCheck WHERE monitorId = 'abc-123' AND result = 'FAILED' AND location IN ('AWS_EU_CENTRAL_1', 'AWS_EU_WEST_1')",
+		syntheticsMonitorLocationRoutingQuery("abc-123", []string{"AWS_EU_CENTRAL_1", "AWS_EU_WEST_1"}),
+	)
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_LocationRouting(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+	providerConfig := &ProviderConfig{
+		SyntheticsLocationGroups: map[string][]string{
+			"eu": {"AWS_EU_CENTRAL_1", "AWS_EU_WEST_1"},
+			"us": {"AWS_US_EAST_1"},
+		},
+	}
+
+	config := map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"frequency": 5,
+		"status":    "ENABLED",
+		"locations": []interface{}{"AWS_EU_WEST_1", "AWS_US_EAST_1"},
+		"uri":       "https://example.com",
+		"location_routing": []interface{}{
+			map[string]interface{}{"location_group": "eu", "policy_id": 1},
+			map[string]interface{}{"location_group": "us", "policy_id": 2},
+		},
+	}
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), providerConfig)
+	require.NoError(t, err)
+
+	config["location_routing"] = []interface{}{
+		map[string]interface{}{"location_group": "eu", "policy_id": 1},
+		map[string]interface{}{"location_group": "eu", "policy_id": 2},
+	}
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), providerConfig)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `location group "eu" is routed more than once`)
+
+	config["location_routing"] = []interface{}{
+		map[string]interface{}{"location_group": "apac", "policy_id": 1},
+	}
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), providerConfig)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "apac")
+}
+
+func TestResourceNewRelicSyntheticsMonitorDelete_DeletesLocationRoutingConditions(t *testing.T) {
+	var requests []string
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "alertsConditionDelete") {
+			requests = append(requests, "condition")
+			_, _ = w.Write([]byte(`{"data":{"alertsConditionDelete":{"id":"42"}}}`))
+			return
+		}
+		require.Equal(t, http.MethodDelete, r.Method)
+		requests = append(requests, "monitor")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{})
+	d.SetId("abc-123")
+	require.NoError(t, d.Set("location_routing", []interface{}{
+		map[string]interface{}{"location_group": "eu", "policy_id": 1, "condition_id": "42"},
+		map[string]interface{}{"location_group": "us", "policy_id": 2, "condition_id": "43"},
+	}))
+
+	diags := resourceNewRelicSyntheticsMonitorDelete(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []string{"condition", "condition", "monitor"}, requests)
+}
+
+func TestSyncSyntheticsMonitorLocationRouting_PartialFailure(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case strings.Contains(string(body), "alertsNrqlConditionStaticCreate"):
+			_, _ = w.Write([]byte(`{"data":{"alertsNrqlConditionStaticCreate":{"id":"42"}}}`))
+		case strings.Contains(string(body), `"policyID":"2"`):
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Not Found"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"alerts":{"policy":{"id":"1"}}}}}}`))
+		}
+	})
+	providerConfig.SyntheticsLocationGroups = map[string][]string{
+		"eu": {"AWS_EU_WEST_1"},
+		"us": {"AWS_US_EAST_1"},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name": "foo",
+		"location_routing": []interface{}{
+			map[string]interface{}{"location_group": "eu", "policy_id": 1},
+			map[string]interface{}{"location_group": "us", "policy_id": 2},
+		},
+	})
+	d.SetId("abc-123")
+
+	// The condition created before the failure stays in state.
+	diags := syncSyntheticsMonitorLocationRouting(context.Background(), d, providerConfig, providerConfig.NewClient, 123)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "error looking up alert policy 2")
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"location_group": "eu", "policy_id": 1, "condition_id": "42"},
+	}, d.Get("location_routing"))
+}
@@ -8,6 +8,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +24,7 @@ func resourceNewRelicSyntheticsMonitorScript() *schema.Resource {
 		ReadContext:   resourceNewRelicSyntheticsMonitorScriptRead,
 		UpdateContext: resourceNewRelicSyntheticsMonitorScriptUpdate,
 		DeleteContext: resourceNewRelicSyntheticsMonitorScriptDelete,
+		CustomizeDiff: resourceNewRelicSyntheticsMonitorScriptCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: importSyntheticsMonitorScript,
 		},
@@ -32,10 +36,13 @@ func resourceNewRelicSyntheticsMonitorScript() *schema.Resource {
 				Description: "The ID of the monitor to attach the script to.",
 			},
 			"text": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The plaintext representing the monitor script.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"text", "step"},
+				Description:  "The plaintext representing the monitor script.",
 			},
+			"step": syntheticsMonitorScriptStepSchema(),
 			"location": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -58,6 +65,12 @@ func resourceNewRelicSyntheticsMonitorScript() *schema.Resource {
 							Sensitive:   true,
 							Description: "The password for the monitor script location used to calculate HMAC. Use only one of `vse_password` or `hmac.`",
 						},
+						"vse_password_source": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSecretSource(),
+							Description:  "Where to read `vse_password` from at apply time, as `env:<NAME>` or `file:<path>`. Use instead of `vse_password`.",
+						},
 					},
 				},
 			},
@@ -65,19 +78,99 @@ func resourceNewRelicSyntheticsMonitorScript() *schema.Resource {
 	}
 }
 
+var secureCredentialReferenceRegexp = regexp.MustCompile(`\$secure\.([A-Za-z0-9_]+)`)
+
+// resourceNewRelicSyntheticsMonitorScriptCustomizeDiff plans the text compiled
+// from step blocks and checks, when enabled in the provider, that the secure
+// credentials referenced by a changed script exist. Credentials created in the
+// same apply don't exist yet at plan time.
+func resourceNewRelicSyntheticsMonitorScriptCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if steps, ok := diff.GetOk("step"); ok {
+		if !diff.NewValueKnown("step") {
+			return diff.SetNewComputed("text")
+		}
+
+		if err := validateSyntheticsMonitorScriptSteps(steps.([]interface{})); err != nil {
+			return err
+		}
+
+		if text := compileSyntheticsMonitorScriptSteps(steps.([]interface{})); text != diff.Get("text").(string) {
+			if err := diff.SetNew("text", text); err != nil {
+				return err
+			}
+		}
+	}
+
+	providerConfig, _ := meta.(*ProviderConfig)
+	if providerConfig == nil || !providerConfig.ValidateSecureCredentialRefs || !diff.HasChange("text") || !diff.NewValueKnown("text") {
+		return nil
+	}
+
+	refs := secureCredentialReferences(diff.Get("text").(string))
+	if len(refs) == 0 {
+		return nil
+	}
+
+	credentials, err := listSyntheticsSecureCredentials(ctx, providerConfig)
+	if err != nil {
+		return fmt.Errorf("error listing secure credentials to validate the script: %w", err)
+	}
+
+	existing := make(map[string]bool, len(credentials))
+	for _, c := range credentials {
+		existing[c.Key] = true
+	}
+
+	var missing []string
+	for _, ref := range refs {
+		if !existing[ref] {
+			missing = append(missing, ref)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("text references secure credentials that don't exist: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// secureCredentialReferences returns the keys referenced as $secure.<KEY> in
+// the script, sorted and without duplicates.
+func secureCredentialReferences(text string) []string {
+	seen := map[string]bool{}
+	var keys []string
+
+	for _, m := range secureCredentialReferenceRegexp.FindAllStringSubmatch(text, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			keys = append(keys, m[1])
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
 func importSyntheticsMonitorScript(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	_ = d.Set("monitor_id", d.Id())
 	return []*schema.ResourceData{d}, nil
 }
 
 func buildSyntheticsMonitorScriptStruct(d *schema.ResourceData) (*synthetics.MonitorScript, error) {
-	locations, err := expandMonitorScriptLocations(d.Get("location").([]interface{}), d)
+	text := d.Get("text").(string)
+	if steps, ok := d.GetOk("step"); ok {
+		text = compileSyntheticsMonitorScriptSteps(steps.([]interface{}))
+	}
+
+	locations, err := expandMonitorScriptLocations(d.Get("location").([]interface{}), text)
 	if err != nil {
 		return nil, err
 	}
 
 	script := synthetics.MonitorScript{
-		Text:      d.Get("text").(string),
+		Text:      text,
 		Locations: locations,
 	}
 
@@ -160,7 +253,7 @@ func resourceNewRelicSyntheticsMonitorScriptDelete(ctx context.Context, d *schem
 	return nil
 }
 
-func expandMonitorScriptLocations(cfg []interface{}, d *schema.ResourceData) ([]synthetics.MonitorScriptLocation, error) {
+func expandMonitorScriptLocations(cfg []interface{}, text string) ([]synthetics.MonitorScriptLocation, error) {
 	var locations []synthetics.MonitorScriptLocation
 
 	if len(cfg) == 0 {
@@ -178,12 +271,23 @@ func expandMonitorScriptLocations(cfg []interface{}, d *schema.ResourceData) ([]
 			location.Name = n.(string)
 		}
 
+		if src, ok := cfgLocation["vse_password_source"]; ok && src != "" {
+			if v, ok := cfgLocation["vse_password"]; ok && v != "" {
+				return nil, fmt.Errorf("only set one of either 'vse_password' or 'vse_password_source'")
+			}
+			password, err := resolveSecretSource(src.(string))
+			if err != nil {
+				return nil, err
+			}
+			cfgLocation["vse_password"] = password
+		}
+
 		if v, ok := cfgLocation["vse_password"]; ok && v != "" {
 			if h, ok := cfgLocation["hmac"]; ok && h != "" {
 				return nil, fmt.Errorf("only set one of either 'hmac' or 'vse_password'")
 			}
 			mac := hmac.New(sha256.New, []byte(v.(string)))
-			mac.Write([]byte(d.Get("text").(string)))
+			mac.Write([]byte(text))
 			h := hex.EncodeToString(mac.Sum(nil))
 			encoded := base64.StdEncoding.EncodeToString([]byte(h))
 			location.HMAC = encoded
@@ -0,0 +1,203 @@
+package newrelic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// syntheticsMonitorScriptStepContentTypes are the request body content types
+// a step can set.
+var syntheticsMonitorScriptStepContentTypes = []string{
+	"application/json",
+	"application/x-www-form-urlencoded",
+	"application/xml",
+	"application/graphql",
+	"application/octet-stream",
+	"multipart/form-data",
+	"text/plain",
+	"text/xml",
+	"text/csv",
+}
+
+func syntheticsMonitorScriptStepSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ExactlyOneOf: []string{"text", "step"},
+		Description:  "Ordered API requests to compile into the script, for SCRIPT_API monitors. Use instead of text.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"method": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      http.MethodGet,
+					ValidateFunc: validation.StringInSlice([]string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}, false),
+					Description:  "The HTTP method of the request.",
+				},
+				"url": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "The URL of the request.",
+				},
+				"headers": {
+					Type:        schema.TypeMap,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Optional:    true,
+					Description: "The request headers.",
+				},
+				"body": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The request body.",
+				},
+				"request_content_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(syntheticsMonitorScriptStepContentTypes, false),
+					Description:  "The Content-Type header of the request body. A Content-Type set in headers takes precedence.",
+				},
+				"expected_status": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(100, 599),
+					Description:  "Fail the check unless the response has this status code.",
+				},
+				"body_contains": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Fail the check unless the response body contains this string.",
+				},
+			},
+		},
+	}
+}
+
+// syntheticsMonitorScriptStepsPrelude runs a single request with the API
+// runtime's $http client and checks the response.
+const syntheticsMonitorScriptStepsPrelude = `// Generated by the New Relic Terraform provider from step blocks.
+var assert = require('assert');
+
+function runStep(number, options, expectedStatus, bodyContains) {
+  return new Promise(function (resolve, reject) {
+    $http(options, function (err, response, body) {
+      if (err) {
+        return reject(err);
+      }
+      try {
+        if (expectedStatus !== null) {
+          assert.equal(response.statusCode, expectedStatus, 'step ' + number + ': expected status ' + expectedStatus + ', got ' + response.statusCode);
+        }
+        if (bodyContains !== null) {
+          assert.ok(String(body).indexOf(bodyContains) !== -1, 'step ' + number + ': response body does not contain ' + JSON.stringify(bodyContains));
+        }
+      } catch (e) {
+        return reject(e);
+      }
+      resolve();
+    });
+  });
+}
+
+Promise.resolve()
+`
+
+// compileSyntheticsMonitorScriptSteps generates the script running the steps
+// in order, stopping at the first failure. The output depends only on the
+// steps, and JSON encoding sorts the header names, so unchanged steps always
+// compile to the same text.
+func compileSyntheticsMonitorScriptSteps(steps []interface{}) string {
+	var b strings.Builder
+
+	b.WriteString(syntheticsMonitorScriptStepsPrelude)
+
+	for i, s := range steps {
+		step := s.(map[string]interface{})
+
+		options := map[string]interface{}{
+			"method": step["method"].(string),
+			"uri":    step["url"].(string),
+		}
+
+		headers, _ := step["headers"].(map[string]interface{})
+		if contentType, _ := step["request_content_type"].(string); contentType != "" && !syntheticsMonitorScriptStepHasHeader(headers, "Content-Type") {
+			withContentType := make(map[string]interface{}, len(headers)+1)
+			for k, v := range headers {
+				withContentType[k] = v
+			}
+			withContentType["Content-Type"] = contentType
+			headers = withContentType
+		}
+
+		if len(headers) > 0 {
+			options["headers"] = headers
+		}
+
+		if body := step["body"].(string); body != "" {
+			options["body"] = body
+		}
+
+		expectedStatus := "null"
+		if status := step["expected_status"].(int); status != 0 {
+			expectedStatus = fmt.Sprint(status)
+		}
+
+		bodyContains := "null"
+		if contains := step["body_contains"].(string); contains != "" {
+			bodyContains = syntheticsMonitorScriptJSON(contains)
+		}
+
+		fmt.Fprintf(&b, "  .then(function () { return runStep(%d, %s, %s, %s); })\n",
+			i+1, syntheticsMonitorScriptJSON(options), expectedStatus, bodyContains)
+	}
+
+	b.WriteString(`  .catch(function (err) {
+    setImmediate(function () { throw err; });
+  });
+`)
+
+	return b.String()
+}
+
+// syntheticsMonitorScriptStepHasHeader reports whether headers set the given
+// header, whose name is case-insensitive.
+func syntheticsMonitorScriptStepHasHeader(headers map[string]interface{}, name string) bool {
+	for k := range headers {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateSyntheticsMonitorScriptSteps checks that steps only set a request
+// content type for methods that send a body.
+func validateSyntheticsMonitorScriptSteps(steps []interface{}) error {
+	for i, s := range steps {
+		step, _ := s.(map[string]interface{})
+		if step == nil {
+			continue
+		}
+
+		contentType, _ := step["request_content_type"].(string)
+		method, _ := step["method"].(string)
+		if contentType != "" && (method == http.MethodGet || method == http.MethodHead) {
+			return fmt.Errorf("step %d: request_content_type can't be set for %s requests, which have no body", i+1, method)
+		}
+	}
+
+	return nil
+}
+
+// syntheticsMonitorScriptJSON encodes a value as a JavaScript literal.
+func syntheticsMonitorScriptJSON(v interface{}) string {
+	// Encoding strings, and maps and slices of them, cannot fail.
+	out, _ := json.Marshal(v)
+	return string(out)
+}
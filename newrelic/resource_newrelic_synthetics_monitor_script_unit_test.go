@@ -0,0 +1,157 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecureCredentialReferences(t *testing.T) {
+	text := "$http.get({headers: {Authorization: $secure.API_TOKEN}});\nvar user = $secure.USER_1;\nvar again = $secure.API_TOKEN;"
+
+	require.Equal(t, []string{"API_TOKEN", "USER_1"}, secureCredentialReferences(text))
+	require.Empty(t, secureCredentialReferences("$http.get('https://example.com');"))
+}
+
+func TestResourceNewRelicSyntheticsMonitorScriptCustomizeDiff_MissingSecureCredentials(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/secure-credentials", r.URL.Path)
+		_, _ = w.Write([]byte(`{"secureCredentials":[{"key":"API_TOKEN"}]}`))
+	})
+	providerConfig.ValidateSecureCredentialRefs = true
+
+	r := resourceNewRelicSyntheticsMonitorScript()
+	diff := func(text string) error {
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"monitor_id": "abc-123",
+			"text":       text,
+		}), providerConfig)
+		return err
+	}
+
+	require.NoError(t, diff("var token = $secure.API_TOKEN;"))
+
+	err := diff("var token = $secure.API_TOKEN; var user = $secure.USERNAME;")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "secure credentials that don't exist: USERNAME")
+}
+
+func TestCompileSyntheticsMonitorScriptSteps(t *testing.T) {
+	steps := []interface{}{
+		map[string]interface{}{
+			"method":          "POST",
+			"url":             "https://api.example.com/login",
+			"headers":         map[string]interface{}{"X-Trace": "1", "Content-Type": "application/json"},
+			"body":            `{"user":"synthetics"}`,
+			"expected_status": 200,
+			"body_contains":   "",
+		},
+		map[string]interface{}{
+			"method":          "GET",
+			"url":             "https://api.example.com/status",
+			"headers":         map[string]interface{}{},
+			"body":            "",
+			"expected_status": 0,
+			"body_contains":   `"ok"`,
+		},
+	}
+
+	text := compileSyntheticsMonitorScriptSteps(steps)
+
+	require.Equal(t, text, compileSyntheticsMonitorScriptSteps(steps))
+	require.Contains(t, text, `.then(function () { return runStep(1, {"body":"{\"user\":\"synthetics\"}","headers":{"Content-Type":"application/json","X-Trace":"1"},"method":"POST","uri":"https://api.example.com/login"}, 200, null); })`)
+	require.Contains(t, text, `.then(function () { return runStep(2, {"method":"GET","uri":"https://api.example.com/status"}, null, "\"ok\""); })`)
+	require.Less(t, strings.Index(text, "runStep(1,"), strings.Index(text, "runStep(2,"))
+}
+
+func TestResourceNewRelicSyntheticsMonitorScriptCustomizeDiff_Steps(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitorScript()
+
+	step := map[string]interface{}{
+		"url":             "https://api.example.com/status",
+		"expected_status": 200,
+	}
+
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"monitor_id": "abc-123",
+		"step":       []interface{}{step},
+	}), &ProviderConfig{})
+	require.NoError(t, err)
+
+	text := diff.Attributes["text"].New
+	require.Contains(t, text, `runStep(1, {"method":"GET","uri":"https://api.example.com/status"}, 200, null)`)
+
+	state := &terraform.InstanceState{
+		ID: "abc-123",
+		Attributes: map[string]string{
+			"id":                     "abc-123",
+			"monitor_id":             "abc-123",
+			"text":                   text,
+			"step.#":                 "1",
+			"step.0.method":          "GET",
+			"step.0.url":             "https://api.example.com/status",
+			"step.0.expected_status": "200",
+			"step.0.headers.%":       "0",
+			"step.0.body":            "",
+			"step.0.body_contains":   "",
+		},
+	}
+
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"monitor_id": "abc-123",
+		"step":       []interface{}{step},
+	}), &ProviderConfig{})
+	require.NoError(t, err)
+	require.Nil(t, diff)
+}
+
+func TestCompileSyntheticsMonitorScriptSteps_RequestContentType(t *testing.T) {
+	step := map[string]interface{}{
+		"method":               "POST",
+		"url":                  "https://api.example.com/login",
+		"headers":              map[string]interface{}{"X-Trace": "1"},
+		"body":                 "user=synthetics",
+		"request_content_type": "application/x-www-form-urlencoded",
+		"expected_status":      0,
+		"body_contains":        "",
+	}
+
+	text := compileSyntheticsMonitorScriptSteps([]interface{}{step})
+	require.Contains(t, text, `{"body":"user=synthetics","headers":{"Content-Type":"application/x-www-form-urlencoded","X-Trace":"1"},"method":"POST","uri":"https://api.example.com/login"}`)
+	require.Equal(t, map[string]interface{}{"X-Trace": "1"}, step["headers"])
+
+	// An explicit header wins over request_content_type.
+	step["headers"] = map[string]interface{}{"content-type": "application/vnd.api+json"}
+	text = compileSyntheticsMonitorScriptSteps([]interface{}{step})
+	require.Contains(t, text, `"headers":{"content-type":"application/vnd.api+json"}`)
+	require.NotContains(t, text, "x-www-form-urlencoded")
+}
+
+func TestResourceNewRelicSyntheticsMonitorScriptCustomizeDiff_RequestContentType(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitorScript()
+	diff := func(method string) error {
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"monitor_id": "abc-123",
+			"step": []interface{}{map[string]interface{}{
+				"method":               method,
+				"url":                  "https://api.example.com/login",
+				"body":                 `{"user":"synthetics"}`,
+				"request_content_type": "application/json",
+			}},
+		}), &ProviderConfig{})
+		return err
+	}
+
+	require.NoError(t, diff("POST"))
+
+	err := diff("GET")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "step 1: request_content_type can't be set for GET requests")
+}
@@ -0,0 +1,349 @@
+package newrelic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+// syntheticsMonitorSetMixedStatus is the status read back when the set's
+// monitors don't all have the same status, so the next plan sets them again.
+const syntheticsMonitorSetMixedStatus = "MIXED"
+
+// resourceNewRelicSyntheticsMonitorSet manages the status of a collection of
+// existing monitors, e.g. to disable them all during a deploy. The rest of
+// each monitor is left untouched, as with newrelic_synthetics_monitor_status.
+func resourceNewRelicSyntheticsMonitorSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicSyntheticsMonitorSetCreate,
+		ReadContext:   resourceNewRelicSyntheticsMonitorSetRead,
+		UpdateContext: resourceNewRelicSyntheticsMonitorSetUpdate,
+		DeleteContext: resourceNewRelicSyntheticsMonitorSetDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"monitor_ids": {
+				Type:         schema.TypeSet,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				ExactlyOneOf: []string{"monitor_ids", "tag"},
+				Description:  "The IDs of the monitors in the set.",
+			},
+			"tag": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"monitor_ids", "tag"},
+				Description:  "Include the monitors having all of these tags. Monitors tagged later join the set on the next apply.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The tag key.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The tag value.",
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The status of every monitor in the set (i.e. ENABLED, MUTED, DISABLED).",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ENABLED",
+					"MUTED",
+					"DISABLED",
+				}, false),
+			},
+			"restore_status_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restore the status each monitor had before it joined the set when the resource is destroyed or the monitor leaves the set.",
+			},
+			"member_ids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The IDs of the existing monitors in the set, sorted.",
+			},
+			"previous_statuses": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The status each monitor had before it joined the set, by monitor ID.",
+			},
+		},
+	}
+}
+
+func resourceNewRelicSyntheticsMonitorSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+
+	members, err := listSyntheticsMonitorSetMembers(ctx, providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	previous := map[string]interface{}{}
+	diags := setSyntheticsMonitorSetStatus(ctx, providerConfig.NewClient, members, d.Get("status").(string), previous, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		return diags
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(members, ",") + ":" + syntheticsMonitorTagQuery(d.Get("tag").([]interface{}))))
+	d.SetId(hex.EncodeToString(sum[:]))
+	_ = d.Set("previous_statuses", previous)
+
+	return append(diags, resourceNewRelicSyntheticsMonitorSetRead(ctx, d, meta)...)
+}
+
+// Read lists the set's monitors again, so monitors tagged or deleted since the
+// last apply join or leave member_ids. When the members don't all have the
+// configured status, including new members, status reads back as MIXED and
+// the next plan sets it again.
+func resourceNewRelicSyntheticsMonitorSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+
+	log.Printf("[INFO] Reading New Relic Synthetics monitor set %s", d.Id())
+
+	ids, err := listSyntheticsMonitorSetMembers(ctx, providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members := []string{}
+	statuses := map[string]bool{}
+	for _, id := range ids {
+		monitor, err := client.Synthetics.GetMonitorWithContext(ctx, id)
+		if err != nil {
+			if _, ok := err.(*errors.NotFound); ok {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+
+		members = append(members, id)
+		statuses[string(monitor.Status)] = true
+	}
+
+	if len(statuses) > 1 || (len(statuses) == 1 && !statuses[d.Get("status").(string)]) {
+		_ = d.Set("status", syntheticsMonitorSetMixedStatus)
+	}
+
+	return diag.FromErr(d.Set("member_ids", members))
+}
+
+// Update returns monitors that left the set to their previous status when
+// restore_status_on_destroy is set, and sets the status of the current
+// members.
+func resourceNewRelicSyntheticsMonitorSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	client := providerConfig.NewClient
+
+	members, err := listSyntheticsMonitorSetMembers(ctx, providerConfig, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	previous := d.Get("previous_statuses").(map[string]interface{})
+
+	var diags diag.Diagnostics
+	for id, status := range previous {
+		if stringInSlice(members, id) {
+			continue
+		}
+
+		if d.Get("restore_status_on_destroy").(bool) {
+			restoreDiags := restoreSyntheticsMonitorSetStatus(ctx, client, id, status.(string), d.Timeout(schema.TimeoutUpdate))
+			diags = append(diags, restoreDiags...)
+			if restoreDiags.HasError() {
+				continue
+			}
+		}
+		delete(previous, id)
+	}
+
+	diags = append(diags, setSyntheticsMonitorSetStatus(ctx, client, members, d.Get("status").(string), previous, d.Timeout(schema.TimeoutUpdate))...)
+	_ = d.Set("previous_statuses", previous)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceNewRelicSyntheticsMonitorSetRead(ctx, d, meta)...)
+}
+
+// Delete restores every member to its previous status when
+// restore_status_on_destroy is set. Each monitor is attempted and each
+// failure is reported separately; the resource stays in state when any fail.
+func resourceNewRelicSyntheticsMonitorSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	if !d.Get("restore_status_on_destroy").(bool) {
+		log.Printf("[INFO] Releasing status of New Relic Synthetics monitor set %s", d.Id())
+		return nil
+	}
+
+	previous := d.Get("previous_statuses").(map[string]interface{})
+	ids := make([]string, 0, len(previous))
+	for id := range previous {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var diags diag.Diagnostics
+	for _, id := range ids {
+		diags = append(diags, restoreSyntheticsMonitorSetStatus(ctx, client, id, previous[id].(string), d.Timeout(schema.TimeoutDelete))...)
+	}
+
+	return diags
+}
+
+// listSyntheticsMonitorSetMembers returns the sorted IDs of the set's
+// monitors: the configured IDs, or the IDs of the account's monitors having
+// all of the set's tags.
+func listSyntheticsMonitorSetMembers(ctx context.Context, providerConfig *ProviderConfig, d *schema.ResourceData) ([]string, error) {
+	ids := []string{}
+
+	if v, ok := d.GetOk("monitor_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			ids = append(ids, id.(string))
+		}
+		sort.Strings(ids)
+
+		return ids, nil
+	}
+
+	ctx, cancel := providerConfig.operationContext(ctx, operationSearch)
+	defer cancel()
+
+	entities, err := searchEntitiesWithTags(ctx, providerConfig.NewClient, syntheticsMonitorTagQuery(d.Get("tag").([]interface{})))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entities {
+		if e.AccountID != providerConfig.AccountID {
+			continue
+		}
+
+		id, err := syntheticsMonitorIDFromGUID(string(e.GUID))
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+// setSyntheticsMonitorSetStatus sets the status of every monitor in ids,
+// recording in previous the status of monitors that weren't members yet.
+// If any monitor fails, the monitors changed by this call are set back to the
+// status they had, so the set isn't left half changed, and every failure is
+// reported.
+func setSyntheticsMonitorSetStatus(ctx context.Context, client *nr.NewRelic, ids []string, status string, previous map[string]interface{}, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	before := map[string]string{}
+	changed := []string{}
+	joined := map[string]bool{}
+
+	for _, id := range ids {
+		log.Printf("[INFO] Setting status of New Relic Synthetics monitor %s to %s", id, status)
+
+		var monitor *synthetics.Monitor
+		err := retrySyntheticsRateLimited(ctx, timeout, func() error {
+			var err error
+			monitor, err = client.Synthetics.GetMonitorWithContext(ctx, id)
+			return err
+		})
+		if _, ok := err.(*errors.NotFound); ok {
+			continue
+		}
+		if err == nil && string(monitor.Status) != status {
+			err = retrySyntheticsRateLimited(ctx, timeout, func() error {
+				_, err := updateSyntheticsMonitorStatus(ctx, client, id, synthetics.MonitorStatusType(status))
+				return err
+			})
+			if err == nil {
+				before[id] = string(monitor.Status)
+				changed = append(changed, id)
+			}
+		}
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("error setting the status of synthetics monitor %s to %s", id, status),
+				Detail:   err.Error(),
+			})
+			continue
+		}
+
+		if _, ok := previous[id]; !ok {
+			previous[id] = string(monitor.Status)
+			joined[id] = true
+		}
+	}
+
+	if !diags.HasError() {
+		return nil
+	}
+
+	for _, id := range changed {
+		log.Printf("[INFO] Rolling back status of New Relic Synthetics monitor %s to %s", id, before[id])
+
+		diags = append(diags, restoreSyntheticsMonitorSetStatus(ctx, client, id, before[id], timeout)...)
+		if joined[id] {
+			delete(previous, id)
+		}
+	}
+
+	return diags
+}
+
+// restoreSyntheticsMonitorSetStatus sets a monitor back to the given status.
+// A monitor deleted in the meantime needs no restoring.
+func restoreSyntheticsMonitorSetStatus(ctx context.Context, client *nr.NewRelic, id string, status string, timeout time.Duration) diag.Diagnostics {
+	log.Printf("[INFO] Restoring status of New Relic Synthetics monitor %s to %s", id, status)
+
+	err := retrySyntheticsRateLimited(ctx, timeout, func() error {
+		_, err := updateSyntheticsMonitorStatus(ctx, client, id, synthetics.MonitorStatusType(status))
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return nil
+		}
+
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("error restoring the status of synthetics monitor %s to %s", id, status),
+			Detail:   err.Error(),
+		}}
+	}
+
+	return nil
+}
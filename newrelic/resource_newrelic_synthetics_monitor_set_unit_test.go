@@ -0,0 +1,110 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	"github.com/stretchr/testify/require"
+)
+
+// testSyntheticsMonitorSetServer serves monitors whose status can be changed
+// with PUT, except for the monitors in failing.
+func testSyntheticsMonitorSetServer(t *testing.T, statuses map[string]string, failing ...string) (*ProviderConfig, *[]string) {
+	var updates []string
+
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		id := path.Base(r.URL.Path)
+		if _, ok := statuses[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodPut {
+			var monitor synthetics.Monitor
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&monitor))
+			updates = append(updates, id+"="+string(monitor.Status))
+
+			if stringInSlice(failing, id) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			statuses[id] = string(monitor.Status)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		monitor := testSyntheticsMonitor()
+		monitor.ID = id
+		monitor.Status = synthetics.MonitorStatusType(statuses[id])
+		_ = json.NewEncoder(w).Encode(monitor)
+	})
+
+	return providerConfig, &updates
+}
+
+func TestResourceNewRelicSyntheticsMonitorSetCreate(t *testing.T) {
+	statuses := map[string]string{"a": "ENABLED", "b": "MUTED"}
+	providerConfig, updates := testSyntheticsMonitorSetServer(t, statuses)
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitorSet().Schema, map[string]interface{}{
+		"monitor_ids": []interface{}{"a", "b", "deleted"},
+		"status":      "DISABLED",
+	})
+
+	diags := resourceNewRelicSyntheticsMonitorSetCreate(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []string{"a=DISABLED", "b=DISABLED"}, *updates)
+	require.Equal(t, []interface{}{"a", "b"}, d.Get("member_ids"))
+	require.Equal(t, map[string]interface{}{"a": "ENABLED", "b": "MUTED"}, d.Get("previous_statuses"))
+	require.Equal(t, "DISABLED", d.Get("status"))
+
+	// A member changed outside of Terraform shows up as a diff.
+	statuses["b"] = "ENABLED"
+	diags = resourceNewRelicSyntheticsMonitorSetRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, syntheticsMonitorSetMixedStatus, d.Get("status"))
+}
+
+func TestResourceNewRelicSyntheticsMonitorSetCreate_RollsBackOnFailure(t *testing.T) {
+	statuses := map[string]string{"a": "ENABLED", "b": "ENABLED", "c": "ENABLED"}
+	providerConfig, updates := testSyntheticsMonitorSetServer(t, statuses, "b")
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitorSet().Schema, map[string]interface{}{
+		"monitor_ids": []interface{}{"a", "b", "c"},
+		"status":      "DISABLED",
+	})
+
+	diags := resourceNewRelicSyntheticsMonitorSetCreate(context.Background(), d, providerConfig)
+	require.True(t, diags.HasError())
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Summary, "synthetics monitor b")
+	require.Equal(t, []string{"a=DISABLED", "b=DISABLED", "c=DISABLED", "a=ENABLED", "c=ENABLED"}, *updates)
+	require.Equal(t, map[string]string{"a": "ENABLED", "b": "ENABLED", "c": "ENABLED"}, statuses)
+	require.Empty(t, d.Id())
+}
+
+func TestResourceNewRelicSyntheticsMonitorSetDelete_RestoresStatus(t *testing.T) {
+	statuses := map[string]string{"a": "DISABLED", "b": "DISABLED"}
+	providerConfig, updates := testSyntheticsMonitorSetServer(t, statuses)
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitorSet().Schema, map[string]interface{}{
+		"monitor_ids":               []interface{}{"a", "b"},
+		"status":                    "DISABLED",
+		"restore_status_on_destroy": true,
+	})
+	d.SetId("set")
+	require.NoError(t, d.Set("previous_statuses", map[string]interface{}{"a": "ENABLED", "b": "MUTED"}))
+
+	diags := resourceNewRelicSyntheticsMonitorSetDelete(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []string{"a=ENABLED", "b=MUTED"}, *updates)
+}
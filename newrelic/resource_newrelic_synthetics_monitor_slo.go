@@ -0,0 +1,300 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/common"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/servicelevel"
+)
+
+// syntheticsMonitorSLOMaxTargetMs is the longest a synthetics check can run,
+// in milliseconds. A target at or above it counts every successful check as
+// good.
+const syntheticsMonitorSLOMaxTargetMs = 180000
+
+// syntheticsMonitorSLOPercentiles maps each percentile to the share of checks,
+// in percent, that must finish under the target.
+var syntheticsMonitorSLOPercentiles = map[string]float64{
+	"p50": 50,
+	"p90": 90,
+	"p95": 95,
+	"p99": 99,
+}
+
+// syntheticsMonitorSLOGoodEventsPrefix precedes the target in the where
+// clause of the SLI's good events.
+const syntheticsMonitorSLOGoodEventsPrefix = "AND duration < "
+
+func syntheticsMonitorSLOSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "A latency objective for the monitor's checks, managed as a service level indicator on the monitor's entity.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"percentile": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"p50", "p90", "p95", "p99"}, false),
+					Description:  "The share of checks that must succeed under target_ms. Valid values are p50, p90, p95, and p99.",
+				},
+				"target_ms": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, syntheticsMonitorSLOMaxTargetMs-1),
+					Description:  "The duration, in milliseconds, a successful check must finish under to count towards the objective.",
+				},
+				"rolling_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      7,
+					ValidateFunc: validation.IntInSlice([]int{1, 7, 28}),
+					Description:  "The rolling window, in days, the objective is evaluated over. Valid values are 1, 7, and 28.",
+				},
+				"sli_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the service level indicator.",
+				},
+			},
+		},
+	}
+}
+
+func syntheticsMonitorSLOConfig(v interface{}) map[string]interface{} {
+	l, _ := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	return l[0].(map[string]interface{})
+}
+
+// validateSyntheticsMonitorSLO checks at plan time that the monitor runs
+// enough checks in the objective's window for its percentile to be measured:
+// a p99 objective over fewer than 100 checks is breached by a single slow
+// check.
+func validateSyntheticsMonitorSLO(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("slo") || !diff.NewValueKnown("frequency") {
+		return nil
+	}
+
+	cfg := syntheticsMonitorSLOConfig(diff.Get("slo"))
+	frequency := diff.Get("frequency").(int)
+	if cfg == nil || frequency <= 0 {
+		return nil
+	}
+
+	percentile := cfg["percentile"].(string)
+	share, ok := syntheticsMonitorSLOPercentiles[percentile]
+	if !ok {
+		return nil
+	}
+
+	days := cfg["rolling_days"].(int)
+	checks := days * 24 * 60 / frequency
+	required := int(100 / (100 - share))
+
+	if checks < required {
+		return fmt.Errorf("slo: a %s objective needs at least %d checks in its window, but a monitor running every %d minutes runs %d checks in %d days; use a longer rolling_days, a higher frequency, or a lower percentile", percentile, required, frequency, checks, days)
+	}
+
+	return nil
+}
+
+func syntheticsMonitorSLOWhere(monitorID string) string {
+	return fmt.Sprintf("monitorId = '%s'", monitorID)
+}
+
+func syntheticsMonitorSLOGoodWhere(monitorID string, targetMs int) string {
+	return fmt.Sprintf("%s AND result = 'SUCCESS' %s%d", syntheticsMonitorSLOWhere(monitorID), syntheticsMonitorSLOGoodEventsPrefix, targetMs)
+}
+
+func syntheticsMonitorSLOName(monitorName string, cfg map[string]interface{}) string {
+	return fmt.Sprintf("%s %s under %d ms", monitorName, cfg["percentile"].(string), cfg["target_ms"].(int))
+}
+
+func expandSyntheticsMonitorSLOCreateInput(accountID int, monitorID string, monitorName string, cfg map[string]interface{}) servicelevel.ServiceLevelIndicatorCreateInput {
+	return servicelevel.ServiceLevelIndicatorCreateInput{
+		Name: syntheticsMonitorSLOName(monitorName, cfg),
+		Events: servicelevel.ServiceLevelEventsCreateInput{
+			AccountID: accountID,
+			ValidEvents: &servicelevel.ServiceLevelEventsQueryCreateInput{
+				From:  "SyntheticCheck",
+				Where: servicelevel.NRQL(syntheticsMonitorSLOWhere(monitorID)),
+			},
+			GoodEvents: &servicelevel.ServiceLevelEventsQueryCreateInput{
+				From:  "SyntheticCheck",
+				Where: servicelevel.NRQL(syntheticsMonitorSLOGoodWhere(monitorID, cfg["target_ms"].(int))),
+			},
+		},
+		Objectives: []servicelevel.ServiceLevelObjectiveCreateInput{{
+			Target: syntheticsMonitorSLOPercentiles[cfg["percentile"].(string)],
+			TimeWindow: servicelevel.ServiceLevelObjectiveTimeWindowCreateInput{
+				Rolling: servicelevel.ServiceLevelObjectiveRollingTimeWindowCreateInput{
+					Count: cfg["rolling_days"].(int),
+					Unit:  servicelevel.ServiceLevelObjectiveRollingTimeWindowUnitTypes.DAY,
+				},
+			},
+		}},
+	}
+}
+
+func expandSyntheticsMonitorSLOUpdateInput(monitorID string, monitorName string, cfg map[string]interface{}) servicelevel.ServiceLevelIndicatorUpdateInput {
+	return servicelevel.ServiceLevelIndicatorUpdateInput{
+		Name: syntheticsMonitorSLOName(monitorName, cfg),
+		Events: &servicelevel.ServiceLevelEventsUpdateInput{
+			ValidEvents: &servicelevel.ServiceLevelEventsQueryUpdateInput{
+				From:  "SyntheticCheck",
+				Where: servicelevel.NRQL(syntheticsMonitorSLOWhere(monitorID)),
+			},
+			GoodEvents: &servicelevel.ServiceLevelEventsQueryUpdateInput{
+				From:  "SyntheticCheck",
+				Where: servicelevel.NRQL(syntheticsMonitorSLOGoodWhere(monitorID, cfg["target_ms"].(int))),
+			},
+		},
+		Objectives: []servicelevel.ServiceLevelObjectiveUpdateInput{{
+			Target: syntheticsMonitorSLOPercentiles[cfg["percentile"].(string)],
+			TimeWindow: servicelevel.ServiceLevelObjectiveTimeWindowUpdateInput{
+				Rolling: servicelevel.ServiceLevelObjectiveRollingTimeWindowUpdateInput{
+					Count: cfg["rolling_days"].(int),
+					Unit:  servicelevel.ServiceLevelObjectiveRollingTimeWindowUnitTypes.DAY,
+				},
+			},
+		}},
+	}
+}
+
+// syncSyntheticsMonitorSLO creates, updates or deletes the monitor's service
+// level indicator to match its slo block. sliID is the indicator currently
+// attached to the monitor, if any.
+func syncSyntheticsMonitorSLO(ctx context.Context, d *schema.ResourceData, client *nr.NewRelic, accountID int, sliID string) diag.Diagnostics {
+	cfg := syntheticsMonitorSLOConfig(d.Get("slo"))
+
+	if cfg == nil {
+		if sliID != "" {
+			if err := deleteSyntheticsMonitorSLO(ctx, client, sliID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return nil
+	}
+
+	monitorName := d.Get("name").(string)
+
+	if sliID == "" {
+		log.Printf("[INFO] Creating service level indicator of New Relic Synthetics monitor %s", d.Id())
+
+		indicator, err := client.ServiceLevel.ServiceLevelCreateWithContext(ctx, syntheticsMonitorGUID(accountID, d.Id()), expandSyntheticsMonitorSLOCreateInput(accountID, d.Id(), monitorName, cfg))
+		if err != nil {
+			return diag.Errorf("error creating service level indicator for synthetics monitor %s: %s", d.Id(), err)
+		}
+		sliID = indicator.ID
+	} else {
+		log.Printf("[INFO] Updating service level indicator %s of New Relic Synthetics monitor %s", sliID, d.Id())
+
+		if _, err := client.ServiceLevel.ServiceLevelUpdateWithContext(ctx, sliID, expandSyntheticsMonitorSLOUpdateInput(d.Id(), monitorName, cfg)); err != nil {
+			return diag.Errorf("error updating service level indicator %s of synthetics monitor %s: %s", sliID, d.Id(), err)
+		}
+	}
+
+	cfg["sli_id"] = sliID
+	_ = d.Set("slo", []interface{}{cfg})
+
+	return nil
+}
+
+// syntheticsMonitorSLOID returns the ID of the service level indicator
+// recorded in the given slo value.
+func syntheticsMonitorSLOID(v interface{}) string {
+	if cfg := syntheticsMonitorSLOConfig(v); cfg != nil {
+		return cfg["sli_id"].(string)
+	}
+
+	return ""
+}
+
+// readSyntheticsMonitorSLO refreshes the service level indicator. An
+// indicator deleted outside of Terraform is dropped from state so it gets
+// recreated.
+func readSyntheticsMonitorSLO(ctx context.Context, d *schema.ResourceData, client *nr.NewRelic, accountID int) error {
+	cfg := syntheticsMonitorSLOConfig(d.Get("slo"))
+	if cfg == nil || cfg["sli_id"].(string) == "" {
+		return nil
+	}
+
+	sliID := cfg["sli_id"].(string)
+	sliGUID := getSliGUID(&serviceLevelIdentifier{AccountID: accountID, ID: sliID})
+
+	indicators, err := client.ServiceLevel.GetIndicatorsWithContext(ctx, common.EntityGUID(sliGUID))
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); !ok {
+			return err
+		}
+	}
+
+	if indicators != nil {
+		for _, indicator := range *indicators {
+			if indicator.ID != sliID {
+				continue
+			}
+
+			flattenSyntheticsMonitorSLO(indicator, cfg)
+			return d.Set("slo", []interface{}{cfg})
+		}
+	}
+
+	log.Printf("[WARN] Service level indicator %s of New Relic Synthetics monitor %s not found, removing from state", sliID, d.Id())
+
+	return d.Set("slo", []interface{}{})
+}
+
+func flattenSyntheticsMonitorSLO(indicator servicelevel.ServiceLevelIndicator, cfg map[string]interface{}) {
+	if len(indicator.Objectives) > 0 {
+		objective := indicator.Objectives[0]
+
+		for percentile, share := range syntheticsMonitorSLOPercentiles {
+			if share == objective.Target {
+				cfg["percentile"] = percentile
+			}
+		}
+
+		if objective.TimeWindow.Rolling.Count > 0 {
+			cfg["rolling_days"] = objective.TimeWindow.Rolling.Count
+		}
+	}
+
+	if good := indicator.Events.GoodEvents; good != nil {
+		where := string(good.Where)
+		if i := strings.LastIndex(where, syntheticsMonitorSLOGoodEventsPrefix); i >= 0 {
+			if targetMs, err := strconv.Atoi(strings.TrimSpace(where[i+len(syntheticsMonitorSLOGoodEventsPrefix):])); err == nil {
+				cfg["target_ms"] = targetMs
+			}
+		}
+	}
+}
+
+// deleteSyntheticsMonitorSLO deletes a service level indicator, ignoring one
+// that is already gone.
+func deleteSyntheticsMonitorSLO(ctx context.Context, client *nr.NewRelic, sliID string) error {
+	log.Printf("[INFO] Deleting service level indicator %s", sliID)
+
+	if _, err := client.ServiceLevel.ServiceLevelDeleteWithContext(ctx, sliID); err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return nil
+		}
+		return fmt.Errorf("error deleting service level indicator %s: %w", sliID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/pkg/servicelevel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_SLO(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+
+	config := map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"frequency": 60,
+		"status":    "ENABLED",
+		"locations": []interface{}{"AWS_US_EAST_1"},
+		"uri":       "https://example.com",
+		"slo": []interface{}{
+			map[string]interface{}{"percentile": "p99", "target_ms": 500},
+		},
+	}
+
+	// 168 hourly checks in the default 7 days.
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &ProviderConfig{})
+	require.NoError(t, err)
+
+	// 24 hourly checks in a day.
+	config["slo"] = []interface{}{
+		map[string]interface{}{"percentile": "p99", "target_ms": 500, "rolling_days": 1},
+	}
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &ProviderConfig{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a p99 objective needs at least 100 checks")
+
+	config["slo"] = []interface{}{
+		map[string]interface{}{"percentile": "p95", "target_ms": 500, "rolling_days": 1},
+	}
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &ProviderConfig{})
+	require.NoError(t, err)
+}
+
+func TestFlattenSyntheticsMonitorSLO(t *testing.T) {
+	cfg := map[string]interface{}{"percentile": "p50", "target_ms": 1, "rolling_days": 7, "sli_id": "sli-1"}
+
+	indicator := servicelevel.ServiceLevelIndicator{
+		ID: "sli-1",
+		Events: servicelevel.ServiceLevelEvents{
+			GoodEvents: &servicelevel.ServiceLevelEventsQuery{
+				Where: servicelevel.NRQL(syntheticsMonitorSLOGoodWhere("abc-123", 750)),
+			},
+		},
+		Objectives: []servicelevel.ServiceLevelObjective{{
+			Target: 95,
+			TimeWindow: servicelevel.ServiceLevelObjectiveTimeWindow{
+				Rolling: servicelevel.ServiceLevelObjectiveRollingTimeWindow{Count: 28},
+			},
+		}},
+	}
+
+	flattenSyntheticsMonitorSLO(indicator, cfg)
+	require.Equal(t, "p95", cfg["percentile"])
+	require.Equal(t, 750, cfg["target_ms"])
+	require.Equal(t, 28, cfg["rolling_days"])
+}
+
+func TestResourceNewRelicSyntheticsMonitorDelete_DeletesSLO(t *testing.T) {
+	var requests []string
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "serviceLevelDelete") {
+			require.Contains(t, string(body), "sli-1")
+			requests = append(requests, "sli")
+			_, _ = w.Write([]byte(`{"data":{"serviceLevelDelete":{"id":"sli-1"}}}`))
+			return
+		}
+		require.Equal(t, http.MethodDelete, r.Method)
+		requests = append(requests, "monitor")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{})
+	d.SetId("abc-123")
+	require.NoError(t, d.Set("slo", []interface{}{
+		map[string]interface{}{"percentile": "p95", "target_ms": 500, "rolling_days": 7, "sli_id": "sli-1"},
+	}))
+
+	diags := resourceNewRelicSyntheticsMonitorDelete(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []string{"sli", "monitor"}, requests)
+}
@@ -0,0 +1,159 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+func resourceNewRelicSyntheticsMonitorStatus() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicSyntheticsMonitorStatusCreate,
+		ReadContext:   resourceNewRelicSyntheticsMonitorStatusRead,
+		UpdateContext: resourceNewRelicSyntheticsMonitorStatusUpdate,
+		DeleteContext: resourceNewRelicSyntheticsMonitorStatusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"monitor_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of an existing Synthetics monitor.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The monitor status (i.e. ENABLED, MUTED, DISABLED).",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ENABLED",
+					"MUTED",
+					"DISABLED",
+				}, false),
+			},
+			"restore_status_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restore the status the monitor had before it was managed by this resource when the resource is destroyed.",
+			},
+			"previous_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status the monitor had before it was managed by this resource.",
+			},
+		},
+	}
+}
+
+// updateSyntheticsMonitorStatus changes only the status of a monitor. The
+// Synthetics API replaces the whole monitor on update, so the current
+// definition is read first and sent back unchanged apart from the status.
+func updateSyntheticsMonitorStatus(ctx context.Context, client *nr.NewRelic, monitorID string, status synthetics.MonitorStatusType) (*synthetics.Monitor, error) {
+	monitor, err := client.Synthetics.GetMonitorWithContext(ctx, monitorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if monitor.Status == status {
+		return monitor, nil
+	}
+
+	update := synthetics.Monitor{
+		ID:           monitorID,
+		Name:         monitor.Name,
+		Type:         monitor.Type,
+		Frequency:    monitor.Frequency,
+		URI:          monitor.URI,
+		Locations:    monitor.Locations,
+		Status:       status,
+		SLAThreshold: monitor.SLAThreshold,
+		Options:      monitor.Options,
+	}
+
+	return client.Synthetics.UpdateMonitorWithContext(ctx, update)
+}
+
+func resourceNewRelicSyntheticsMonitorStatusCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+	monitorID := d.Get("monitor_id").(string)
+
+	log.Printf("[INFO] Managing status of New Relic Synthetics monitor %s", monitorID)
+
+	monitor, err := client.Synthetics.GetMonitorWithContext(ctx, monitorID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("previous_status", monitor.Status)
+
+	if _, err := updateSyntheticsMonitorStatus(ctx, client, monitorID, synthetics.MonitorStatusType(d.Get("status").(string))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(monitorID)
+
+	return resourceNewRelicSyntheticsMonitorStatusRead(ctx, d, meta)
+}
+
+func resourceNewRelicSyntheticsMonitorStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Reading status of New Relic Synthetics monitor %s", d.Id())
+
+	monitor, err := client.Synthetics.GetMonitorWithContext(ctx, d.Id())
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("monitor_id", monitor.ID)
+	_ = d.Set("status", monitor.Status)
+
+	return nil
+}
+
+func resourceNewRelicSyntheticsMonitorStatusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Updating status of New Relic Synthetics monitor %s", d.Id())
+
+	if _, err := updateSyntheticsMonitorStatus(ctx, client, d.Id(), synthetics.MonitorStatusType(d.Get("status").(string))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNewRelicSyntheticsMonitorStatusRead(ctx, d, meta)
+}
+
+func resourceNewRelicSyntheticsMonitorStatusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	previousStatus := d.Get("previous_status").(string)
+	if !d.Get("restore_status_on_destroy").(bool) || previousStatus == "" {
+		log.Printf("[INFO] Releasing status of New Relic Synthetics monitor %s", d.Id())
+		return nil
+	}
+
+	log.Printf("[INFO] Restoring status of New Relic Synthetics monitor %s to %s", d.Id(), previousStatus)
+
+	if _, err := updateSyntheticsMonitorStatus(ctx, client, d.Id(), synthetics.MonitorStatusType(previousStatus)); err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicSyntheticsMonitorStatus_Basic(t *testing.T) {
+	resourceName := "newrelic_synthetics_monitor_status.foo"
+	rName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsMonitorDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicSyntheticsMonitorStatusConfig(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "previous_status", "DISABLED"),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccNewRelicSyntheticsMonitorStatusConfig(rName, "MUTED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "MUTED"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"previous_status", "restore_status_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccNewRelicSyntheticsMonitorStatusConfig(name string, status string) string {
+	return fmt.Sprintf(`
+resource "newrelic_synthetics_monitor" "foo" {
+	name      = "%[1]s"
+	type      = "SIMPLE"
+	frequency = 1
+	status    = "DISABLED"
+	locations = ["AWS_US_EAST_1"]
+	uri       = "https://example.com"
+
+	lifecycle {
+		ignore_changes = [status]
+	}
+}
+
+resource "newrelic_synthetics_monitor_status" "foo" {
+	monitor_id = newrelic_synthetics_monitor.foo.id
+	status     = "%[2]s"
+}
+`, name, status)
+}
@@ -177,6 +177,47 @@ func TestAccNewRelicSyntheticsMonitor_ScriptAPI(t *testing.T) {
 	})
 }
 
+func TestAccNewRelicSyntheticsMonitor_CreateMuted(t *testing.T) {
+	resourceName := "newrelic_synthetics_monitor.foo"
+	rName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsMonitorDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicSyntheticsMonitorConfigMuted(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "MUTED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNewRelicSyntheticsMonitor_BulkDestroy(t *testing.T) {
+	rName := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicSyntheticsMonitorDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create many monitors, destroyed in parallel afterwards
+			{
+				Config: testAccNewRelicSyntheticsMonitorConfigBulk(rName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicSyntheticsMonitorExists("newrelic_synthetics_monitor.foo.0"),
+					testAccCheckNewRelicSyntheticsMonitorExists("newrelic_synthetics_monitor.foo.49"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNewRelicSyntheticsMonitorExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -364,3 +405,31 @@ resource "newrelic_synthetics_monitor" "foo" {
 }
 `, name)
 }
+
+func testAccNewRelicSyntheticsMonitorConfigBulk(name string, count int) string {
+	return fmt.Sprintf(`
+resource "newrelic_synthetics_monitor" "foo" {
+	count      = %[2]d
+	name       = "%[1]s-${count.index}"
+	type       = "SIMPLE"
+	frequency  = 15
+	status     = "DISABLED"
+	locations  = ["AWS_US_EAST_1"]
+	uri        = "https://example.com"
+}
+`, name, count)
+}
+
+func testAccNewRelicSyntheticsMonitorConfigMuted(name string) string {
+	return fmt.Sprintf(`
+resource "newrelic_synthetics_monitor" "foo" {
+	name      = "%[1]s-muted"
+	type      = "SIMPLE"
+	frequency = 5
+	status    = "MUTED"
+	locations = ["AWS_US_EAST_1"]
+
+	uri = "https://example.com"
+}
+`, name)
+}
@@ -0,0 +1,1336 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	nrErrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	"github.com/stretchr/testify/require"
+)
+
+func testSyntheticsMonitor() *synthetics.Monitor {
+	return &synthetics.Monitor{
+		ID:           "abc-123",
+		Name:         "foo",
+		Type:         synthetics.MonitorTypes.Ping,
+		Frequency:    5,
+		URI:          "https://example.com",
+		Locations:    []string{"AWS_US_EAST_1", "AWS_US_WEST_1"},
+		Status:       synthetics.MonitorStatus.Enabled,
+		SLAThreshold: 7,
+		Options: synthetics.MonitorOptions{
+			ValidationString: "ok",
+			VerifySSL:        true,
+		},
+	}
+}
+
+func TestSyntheticsMonitorChecksum(t *testing.T) {
+	m := testSyntheticsMonitor()
+	checksum := syntheticsMonitorChecksum(m)
+
+	// The checksum must be stable for an unchanged configuration.
+	require.Equal(t, "262d0f2894038ecf46457be1750a772d1e1bc45643190eca9552f5b0aeed0752", checksum)
+
+	// Location ordering must not affect the checksum.
+	m.Locations = []string{"AWS_US_WEST_1", "AWS_US_EAST_1"}
+	require.Equal(t, checksum, syntheticsMonitorChecksum(m))
+
+	// Fields not part of the configuration must not affect the checksum.
+	m.ID = "def-456"
+	m.UserID = 42
+	require.Equal(t, checksum, syntheticsMonitorChecksum(m))
+
+	m.Options.VerifySSL = false
+	require.NotEqual(t, checksum, syntheticsMonitorChecksum(m))
+}
+
+func TestNormalizeSyntheticsMonitorURI(t *testing.T) {
+	cases := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"https://example.com", "https://example.com/", true},
+		{"https://example.com/health/", "https://example.com/health", true},
+		{"https://Example.COM/health", "https://example.com/health", true},
+		{"HTTPS://example.com", "https://example.com/", true},
+		{"https://example.com:443/", "https://example.com", true},
+		{"https://example.com/Health", "https://example.com/health", false},
+		{"https://example.com/?a=1", "https://example.com/?a=2", false},
+		{"https://example.com:8443", "https://example.com", false},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.equal, normalizeSyntheticsMonitorURI(c.a) == normalizeSyntheticsMonitorURI(c.b), "%s vs %s", c.a, c.b)
+	}
+}
+
+func TestSyntheticsMonitorGUID(t *testing.T) {
+	require.Equal(t, "MTIzfFNZTlRIfE1PTklUT1J8YWJjLTEyMw", string(syntheticsMonitorGUID(123, "abc-123")))
+}
+
+func TestSyntheticsMonitorAPISource(t *testing.T) {
+	require.Equal(t, "REST", syntheticsMonitorAPISource("1a2b3c4d-1a2b-1a2b-1a2b-1a2b3c4d5e6f"))
+	require.Equal(t, "NERDGRAPH", syntheticsMonitorAPISource(string(syntheticsMonitorGUID(123, "1a2b3c4d-1a2b-1a2b-1a2b-1a2b3c4d5e6f"))))
+	require.Equal(t, "NERDGRAPH", syntheticsMonitorAPISource("MTIzfFNZTlRIfE1PTklUT1J8YWJjLTEyMw=="))
+}
+
+func TestIsSyntheticsMonitorNotUpdatableError(t *testing.T) {
+	require.True(t, isSyntheticsMonitorNotUpdatableError(nrErrors.NewUnexpectedStatusCode(400, "Field 'locations' is not updatable")))
+	require.False(t, isSyntheticsMonitorNotUpdatableError(nrErrors.NewUnexpectedStatusCode(400, "Invalid frequency")))
+	require.False(t, isSyntheticsMonitorNotUpdatableError(nrErrors.NewUnexpectedStatusCode(500, "Field 'locations' is not updatable")))
+	require.False(t, isSyntheticsMonitorNotUpdatableError(nrErrors.NewNotFound("not updatable")))
+}
+
+func TestValidateSyntheticsLocationsOffline(t *testing.T) {
+	require.NoError(t, validateSyntheticsLocationsOffline([]interface{}{"AWS_US_EAST_1", "AWS_EU_WEST_1"}))
+	require.NoError(t, validateSyntheticsLocationsOffline([]interface{}{"1234-abc.private-location"}))
+
+	err := validateSyntheticsLocationsOffline([]interface{}{"AWS_US_EAST_1", "AWS_MARS_1"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown synthetics locations: AWS_MARS_1")
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_ImportedLocations(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+	providerConfig := &ProviderConfig{ValidateLocationsOffline: true}
+
+	// An imported monitor running in a location missing from the snapshot.
+	state := &terraform.InstanceState{
+		ID: "abc-123",
+		Attributes: map[string]string{
+			"id":          "abc-123",
+			"name":        "foo",
+			"type":        "SIMPLE",
+			"frequency":   "5",
+			"status":      "ENABLED",
+			"uri":         "https://example.com",
+			"locations.#": "1",
+			"locations." + strconv.Itoa(schema.HashString("AWS_RETIRED_1")): "AWS_RETIRED_1",
+		},
+	}
+
+	config := map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"frequency": 5,
+		"status":    "ENABLED",
+		"uri":       "https://example.com",
+		"locations": []interface{}{"AWS_RETIRED_1"},
+	}
+
+	_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), providerConfig)
+	require.NoError(t, err)
+
+	config["locations"] = []interface{}{"AWS_RETIRED_1", "AWS_MARS_1"}
+	_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), providerConfig)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown synthetics locations: AWS_MARS_1")
+}
+
+func TestBuildSyntheticsMonitorStruct_RedirectAndValidation(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":                      "foo",
+		"type":                      "SIMPLE",
+		"frequency":                 5,
+		"status":                    "ENABLED",
+		"locations":                 []interface{}{"AWS_US_EAST_1"},
+		"uri":                       "https://example.com",
+		"validation_string":         "ok",
+		"treat_redirect_as_failure": true,
+	})
+
+	monitor := buildSyntheticsMonitorStruct(d, nil)
+	require.Equal(t, "ok", monitor.Options.ValidationString)
+	require.True(t, monitor.Options.TreatRedirectAsFailure)
+	require.Equal(t, "FAIL_ON_REDIRECT_THEN_VALIDATE", syntheticsMonitorValidationMode(monitor.Options))
+
+	update := buildSyntheticsUpdateMonitorArgs(d, nil)
+	require.Equal(t, monitor.Options, update.Options)
+}
+
+func TestExpandSyntheticsMonitorOptions_BypassHEADRequest(t *testing.T) {
+	cases := map[string]struct {
+		config   map[string]interface{}
+		strict   bool
+		expected bool
+	}{
+		"validation string enables bypass": {
+			config:   map[string]interface{}{"validation_string": "ok"},
+			expected: true,
+		},
+		"explicit false is kept": {
+			config:   map[string]interface{}{"validation_string": "ok", "bypass_head_request": false},
+			expected: false,
+		},
+		"strict passthrough": {
+			config:   map[string]interface{}{"validation_string": "ok"},
+			strict:   true,
+			expected: false,
+		},
+		"no validation string": {
+			config:   map[string]interface{}{},
+			expected: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, tc.config)
+			options := expandSyntheticsMonitorOptions(d, &ProviderConfig{StrictSyntheticsMonitorOptions: tc.strict})
+			require.Equal(t, tc.expected, options.BypassHEADRequest)
+		})
+	}
+}
+
+func TestBuildSyntheticsMonitorStruct_SortedLocations(t *testing.T) {
+	locations := []interface{}{"AWS_US_WEST_1", "AWS_EU_WEST_1", "AWS_AP_SOUTH_1", "AWS_US_EAST_1"}
+
+	for i := 0; i < 3; i++ {
+		d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+			"name":      "foo",
+			"type":      "SIMPLE",
+			"locations": locations,
+		})
+
+		expected := []string{"AWS_AP_SOUTH_1", "AWS_EU_WEST_1", "AWS_US_EAST_1", "AWS_US_WEST_1"}
+		require.Equal(t, expected, buildSyntheticsMonitorStruct(d, nil).Locations)
+		require.Equal(t, expected, buildSyntheticsUpdateMonitorArgs(d, nil).Locations)
+
+		// Rotate the configured order.
+		locations = append(locations[1:], locations[0])
+	}
+}
+
+func TestBuildSyntheticsMonitorStruct_SSLBlock(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"frequency": 5,
+		"status":    "ENABLED",
+		"locations": []interface{}{"AWS_US_EAST_1"},
+		"uri":       "https://example.com",
+		"ssl": []interface{}{
+			map[string]interface{}{"verify_certificate": true},
+		},
+	})
+
+	monitor := buildSyntheticsMonitorStruct(d, nil)
+	require.True(t, monitor.Options.VerifySSL)
+
+	readSyntheticsMonitorStruct(&monitor, d)
+	require.Equal(t, true, d.Get("ssl.0.verify_certificate"))
+	require.Equal(t, false, d.Get("verify_ssl"))
+}
+
+func TestBuildSyntheticsMonitorStruct_URICandidates(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":              "foo",
+		"type":              "SIMPLE",
+		"locations":         []interface{}{"AWS_US_EAST_1"},
+		"uri_candidates":    []interface{}{"https://blue.example.com", "https://green.example.com"},
+		"primary_uri_index": 1,
+	})
+
+	monitor := buildSyntheticsMonitorStruct(d, nil)
+	require.Equal(t, "https://green.example.com", monitor.URI)
+	require.Equal(t, "https://green.example.com", buildSyntheticsUpdateMonitorArgs(d, nil).URI)
+
+	monitor.URI = "https://BLUE.example.com/"
+	readSyntheticsMonitorStruct(&monitor, d)
+	require.Equal(t, 0, d.Get("primary_uri_index"))
+	require.Equal(t, "", d.Get("uri"))
+
+	monitor.URI = "https://red.example.com"
+	readSyntheticsMonitorStruct(&monitor, d)
+	require.Equal(t, -1, d.Get("primary_uri_index"))
+}
+
+func TestBuildSyntheticsMonitorStruct_QueryParameters(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"locations": []interface{}{"AWS_US_EAST_1"},
+		"uri":       "https://example.com/health?verbose=1&env=old",
+		"query_parameters": map[string]interface{}{
+			"token": "a b&c",
+			"env":   "prod",
+		},
+	})
+
+	monitor := buildSyntheticsMonitorStruct(d, nil)
+	require.Equal(t, "https://example.com/health?verbose=1&env=prod&token=a+b%26c", monitor.URI)
+	require.Equal(t, monitor.URI, buildSyntheticsUpdateMonitorArgs(d, nil).URI)
+
+	readSyntheticsMonitorStruct(&monitor, d)
+	require.Equal(t, "https://example.com/health?verbose=1", d.Get("uri"))
+	require.Equal(t, map[string]interface{}{"token": "a b&c", "env": "prod"}, d.Get("query_parameters"))
+
+	// A parameter removed outside of Terraform reads back as a diff.
+	monitor.URI = "https://example.com/health?verbose=1&env=prod"
+	readSyntheticsMonitorStruct(&monitor, d)
+	require.Equal(t, map[string]interface{}{"env": "prod"}, d.Get("query_parameters"))
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_QueryParameters(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+
+	config := map[string]interface{}{
+		"name":             "foo",
+		"type":             "SIMPLE",
+		"frequency":        5,
+		"status":           "ENABLED",
+		"locations":        []interface{}{"AWS_US_EAST_1"},
+		"uri":              "https://example.com",
+		"query_parameters": map[string]interface{}{"env": "prod"},
+	}
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &ProviderConfig{})
+	require.NoError(t, err)
+
+	config["type"] = "SCRIPT_API"
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &ProviderConfig{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "query_parameters can only be set on SIMPLE and BROWSER monitors")
+
+	_, errs := validateSyntheticsMonitorQueryParameters(map[string]interface{}{"": "x"}, "query_parameters")
+	require.Len(t, errs, 1)
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_PrimaryURIIndex(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+
+	config := map[string]interface{}{
+		"name":              "foo",
+		"type":              "SIMPLE",
+		"frequency":         5,
+		"status":            "ENABLED",
+		"locations":         []interface{}{"AWS_US_EAST_1"},
+		"uri_candidates":    []interface{}{"https://blue.example.com", "https://green.example.com"},
+		"primary_uri_index": 1,
+	}
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &ProviderConfig{})
+	require.NoError(t, err)
+
+	config["primary_uri_index"] = 2
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &ProviderConfig{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "primary_uri_index 2 is out of range")
+}
+
+func TestSyntheticsMonitorValidationMode(t *testing.T) {
+	require.Equal(t, "NONE", syntheticsMonitorValidationMode(synthetics.MonitorOptions{}))
+	require.Equal(t, "VALIDATE_FINAL_RESPONSE", syntheticsMonitorValidationMode(synthetics.MonitorOptions{ValidationString: "ok"}))
+	require.Equal(t, "FAIL_ON_REDIRECT", syntheticsMonitorValidationMode(synthetics.MonitorOptions{TreatRedirectAsFailure: true}))
+	require.Equal(t, "FAIL_ON_REDIRECT_THEN_VALIDATE", syntheticsMonitorValidationMode(synthetics.MonitorOptions{ValidationString: "ok", TreatRedirectAsFailure: true}))
+}
+
+func TestExpandSyntheticsMonitorTags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"tag": []interface{}{
+			map[string]interface{}{
+				"key":    "team",
+				"values": []interface{}{"synthetics"},
+			},
+		},
+	})
+
+	tags := expandSyntheticsMonitorTags(d.Get("tag"), map[string]interface{}{
+		"managed_by": "terraform",
+		"env":        "prod",
+	})
+
+	require.Equal(t, []entities.TaggingTagInput{
+		{Key: "team", Values: []string{"synthetics"}},
+		{Key: "env", Values: []string{"prod"}},
+		{Key: "managed_by", Values: []string{"terraform"}},
+	}, tags)
+}
+
+func TestFlattenSyntheticsMonitorTags_SkipsProviderTags(t *testing.T) {
+	tags := flattenSyntheticsMonitorTags([]*entities.TaggingTagInput{
+		{Key: "team", Values: []string{"synthetics"}},
+		{Key: "managed_by", Values: []string{"terraform"}},
+	}, map[string]interface{}{"managed_by": "terraform"})
+
+	require.Equal(t, []map[string]interface{}{
+		{"key": "team", "values": []string{"synthetics"}},
+	}, tags)
+}
+
+func TestIsEntityTaggingPermissionError(t *testing.T) {
+	notPermitted := taggingMutationResultError(&entities.TaggingMutationResult{
+		Errors: []entities.TaggingMutationError{
+			{Type: entities.TaggingMutationErrorTypeTypes.NOT_PERMITTED, Message: "not permitted"},
+		},
+	})
+	require.True(t, isEntityTaggingPermissionError(notPermitted))
+
+	invalidKey := taggingMutationResultError(&entities.TaggingMutationResult{
+		Errors: []entities.TaggingMutationError{
+			{Type: entities.TaggingMutationErrorTypeTypes.INVALID_KEY, Message: "invalid key"},
+		},
+	})
+	require.False(t, isEntityTaggingPermissionError(invalidKey))
+
+	delayed := taggingMutationResultError(&entities.TaggingMutationResult{
+		Errors: []entities.TaggingMutationError{
+			{Type: entities.TaggingMutationErrorTypeTypes.UPDATE_WILL_BE_DELAYED},
+		},
+	})
+	require.NoError(t, delayed)
+
+	require.True(t, isEntityTaggingPermissionError(nrErrors.NewUnexpectedStatusCode(403, "forbidden")))
+	require.False(t, isEntityTaggingPermissionError(nrErrors.NewUnexpectedStatusCode(500, "")))
+	require.False(t, isEntityTaggingPermissionError(errors.New("boom")))
+}
+
+func TestUpdateSyntheticsMonitorTags_PartialFailure(t *testing.T) {
+	calls := 0
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), `"bad"`) {
+			_, _ = w.Write([]byte(`{"data":{"taggingAddTagsToEntity":{"errors":[{"type":"INVALID_KEY","message":"invalid key"}]}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"taggingAddTagsToEntity":{"errors":[]}}}`))
+	})
+
+	tags := []entities.TaggingTagInput{
+		{Key: "team", Values: []string{"synthetics"}},
+		{Key: "bad", Values: []string{"value"}},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{})
+	d.SetId("abc-123")
+
+	diags := updateSyntheticsMonitorTags(context.Background(), d, providerConfig, nil, tags)
+	require.Len(t, diags, 1)
+	require.False(t, diags.HasError())
+	require.Contains(t, diags[0].Detail, "1 of 2 tags could not be added: bad (error tagging entity: INVALID_KEY: invalid key)")
+	require.Equal(t, 3, calls)
+
+	require.NoError(t, d.Set("require_tags", true))
+	diags = updateSyntheticsMonitorTags(context.Background(), d, providerConfig, nil, tags)
+	require.True(t, diags.HasError())
+}
+
+func TestResourceNewRelicSyntheticsMonitorDelete_NotFound(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{})
+	d.SetId("abc-123")
+
+	diags := resourceNewRelicSyntheticsMonitorDelete(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+}
+
+func TestResourceNewRelicSyntheticsMonitorCreate_KeepsIDWhenTaggingFails(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v4/monitors" {
+			w.Header().Set("Location", "https://synthetics.newrelic.com/synthetics/api/v4/monitors/abc-123")
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		_, _ = w.Write([]byte(`{"errors":[{"message":"internal error"}]}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"frequency": 5,
+		"status":    "ENABLED",
+		"locations": []interface{}{"AWS_US_EAST_1"},
+		"uri":       "https://example.com",
+		"tag": []interface{}{
+			map[string]interface{}{"key": "team", "values": []interface{}{"synthetics"}},
+		},
+	})
+
+	diags := resourceNewRelicSyntheticsMonitorCreate(context.Background(), d, providerConfig)
+	require.True(t, diags.HasError())
+	require.Equal(t, "abc-123", d.Id())
+}
+
+func TestResourceNewRelicSyntheticsMonitorCreate_ReadOnlyAdoptsMonitor(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		switch r.URL.Path {
+		case "/v4/monitors":
+			_, _ = w.Write([]byte(`{"monitors":[{"id":"abc-123","name":"foo"},{"id":"def-456","name":"bar"}]}`))
+		case "/v4/monitors/abc-123":
+			_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":10,"status":"ENABLED","locations":["AWS_US_EAST_1"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"frequency": 5,
+		"read_only": true,
+	})
+
+	diags := resourceNewRelicSyntheticsMonitorCreate(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, "abc-123", d.Id())
+	require.Equal(t, 10, d.Get("frequency"))
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Detail, "frequency")
+}
+
+func TestResourceNewRelicSyntheticsMonitorDelete_ReadOnly(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"read_only": true,
+	})
+	d.SetId("abc-123")
+
+	diags := resourceNewRelicSyntheticsMonitorDelete(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+}
+
+func TestDiffSyntheticsMonitors(t *testing.T) {
+	live := testSyntheticsMonitor()
+	desired := *live
+	desired.URI = live.URI + "/"
+	desired.Locations = []string{"AWS_US_WEST_1"}
+	desired.Status = synthetics.MonitorStatus.Muted
+
+	require.Equal(t, []string{"locations", "status"}, diffSyntheticsMonitors(&desired, live))
+
+	// SLA thresholds are compared to millisecond precision.
+	desired = *live
+	desired.SLAThreshold = 6.9999999
+	require.Empty(t, diffSyntheticsMonitors(&desired, live))
+}
+
+func TestDiffSyntheticsMonitors_UnusedOptions(t *testing.T) {
+	live := testSyntheticsMonitor()
+	live.Type = synthetics.MonitorTypes.APITest
+	live.Options = synthetics.MonitorOptions{ValidationString: "ok", VerifySSL: true, BypassHEADRequest: true, TreatRedirectAsFailure: true}
+	desired := *live
+	desired.Options = synthetics.MonitorOptions{}
+
+	require.Empty(t, diffSyntheticsMonitors(&desired, live))
+
+	live.Type = synthetics.MonitorTypes.Browser
+	require.Equal(t, []string{"validation_string", "verify_ssl"}, diffSyntheticsMonitors(&desired, live))
+}
+
+func TestResourceNewRelicSyntheticsMonitorRead_IgnoreExternalLocations(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":5,"status":"ENABLED","locations":["AWS_US_EAST_1","AWS_EU_WEST_1"]}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":                      "foo",
+		"type":                      "SIMPLE",
+		"locations":                 []interface{}{"AWS_US_EAST_1", "AWS_US_WEST_1"},
+		"ignore_external_locations": true,
+	})
+	d.SetId("abc-123")
+
+	diags := resourceNewRelicSyntheticsMonitorRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []interface{}{"AWS_US_EAST_1"}, d.Get("locations").(*schema.Set).List())
+	require.Equal(t, []interface{}{"AWS_EU_WEST_1"}, d.Get("external_locations").(*schema.Set).List())
+
+	// Updates keep the external locations.
+	require.NoError(t, d.Set("locations", []interface{}{"AWS_US_EAST_1", "AWS_US_WEST_1"}))
+	locations := buildSyntheticsUpdateMonitorArgs(d, nil).Locations
+	require.ElementsMatch(t, []string{"AWS_US_EAST_1", "AWS_US_WEST_1", "AWS_EU_WEST_1"}, locations)
+}
+
+func TestResourceNewRelicSyntheticsMonitorRead_PlanVerifyLive(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":5,"status":"MUTED","locations":["AWS_US_EAST_1","AWS_EU_WEST_1"]}`))
+	})
+	providerConfig.PlanVerifyLive = true
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":                      "foo",
+		"type":                      "SIMPLE",
+		"frequency":                 5,
+		"status":                    "ENABLED",
+		"locations":                 []interface{}{"AWS_US_EAST_1"},
+		"ignore_external_locations": true,
+	})
+	d.SetId("abc-123")
+
+	// The external location is reported even though Terraform ignores it.
+	diags := resourceNewRelicSyntheticsMonitorRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Contains(t, diags[0].Detail, "locations, status")
+
+	// Read already stored the live monitor, so a second read finds no drift.
+	diags = resourceNewRelicSyntheticsMonitorRead(context.Background(), d, providerConfig)
+	require.Empty(t, diags)
+}
+
+func TestResourceNewRelicSyntheticsMonitorRead_PlanVerifyLiveScriptOptions(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SCRIPT_API","frequency":5,"status":"ENABLED","locations":["AWS_US_EAST_1"],"options":{"validationString":"ok","verifySSL":true,"bypassHEADRequest":true,"treatRedirectAsFailure":true}}`))
+	})
+	providerConfig.PlanVerifyLive = true
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":      "foo",
+		"type":      "SCRIPT_API",
+		"frequency": 5,
+		"status":    "ENABLED",
+		"locations": []interface{}{"AWS_US_EAST_1"},
+	})
+	d.SetId("abc-123")
+
+	// Scripted monitors don't use the options, so their values aren't drift.
+	for i := 0; i < 2; i++ {
+		diags := resourceNewRelicSyntheticsMonitorRead(context.Background(), d, providerConfig)
+		require.Empty(t, diags)
+	}
+}
+
+func TestResourceNewRelicSyntheticsMonitorRead_EntityGUID(t *testing.T) {
+	guid := syntheticsMonitorGUID(123, "abc-123")
+
+	var entityLookups int32
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			atomic.AddInt32(&entityLookups, 1)
+			_, _ = w.Write([]byte(`{"data":{"actor":{"entity":{"__typename":"SyntheticMonitorEntity","guid":"` + string(guid) + `","monitorId":"abc-123"}}}}`))
+			return
+		}
+		require.True(t, strings.HasSuffix(r.URL.Path, "/monitors/abc-123"), r.URL.Path)
+		_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":5,"status":"ENABLED","locations":["AWS_US_EAST_1"]}`))
+	})
+
+	// The GUID resolves once, even when the caller that resolved it is gone.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 2; i++ {
+		monitorID, err := resolveSyntheticsMonitorID(ctx, providerConfig, providerConfig.NewClient, guid)
+		require.NoError(t, err)
+		require.Equal(t, "abc-123", monitorID)
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&entityLookups))
+
+	// Read decodes the monitor ID from the GUID and tracks the monitor by it
+	// from then on.
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{})
+	d.SetId(string(guid))
+
+	diags := resourceNewRelicSyntheticsMonitorRead(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, "foo", d.Get("name"))
+	require.Equal(t, "abc-123", d.Id())
+	require.Equal(t, int32(1), atomic.LoadInt32(&entityLookups))
+}
+
+func TestResourceNewRelicSyntheticsMonitorRead_APISource(t *testing.T) {
+	guid := syntheticsMonitorGUID(123, "abc-123")
+
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo","type":"SIMPLE","frequency":5,"status":"ENABLED","locations":["AWS_US_EAST_1"]}`))
+	})
+
+	for id, apiSource := range map[string]string{"abc-123": "REST", string(guid): "NERDGRAPH"} {
+		d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{})
+		d.SetId(id)
+
+		// The API is kept once the monitor is tracked by its monitor ID.
+		for i := 0; i < 2; i++ {
+			diags := resourceNewRelicSyntheticsMonitorRead(context.Background(), d, providerConfig)
+			require.False(t, diags.HasError())
+			require.Equal(t, apiSource, d.Get("api_source"), id)
+		}
+	}
+}
+
+func TestResourceNewRelicSyntheticsMonitorDelete_Error(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{})
+	d.SetId("abc-123")
+
+	diags := resourceNewRelicSyntheticsMonitorDelete(context.Background(), d, providerConfig)
+	require.True(t, diags.HasError())
+}
+
+func TestExpandSyntheticsMonitorAlertCreateInput(t *testing.T) {
+	input := expandSyntheticsMonitorAlertCreateInput("abc-123", "foo", map[string]interface{}{
+		"policy_id":          1,
+		"duration_ms":        5000,
+		"severity":           "critical",
+		"threshold_duration": 300,
+		"runbook_url":        "https://example.com/runbook",
+		"enabled":            true,
+	})
+
+	require.Equal(t, "foo check duration", input.Name)
+	require.Equal(t, "SELECT average(duration) FROM SyntheticCheck WHERE monitorId = 'abc-123'", input.Nrql.Query)
+	require.Len(t, input.Terms, 1)
+	require.Equal(t, alerts.NrqlConditionPriorities.Critical, input.Terms[0].Priority)
+	require.Equal(t, float64(5000), *input.Terms[0].Threshold)
+	require.Equal(t, 300, input.Terms[0].ThresholdDuration)
+
+	input = expandSyntheticsMonitorAlertCreateInput("abc-123", "foo", map[string]interface{}{
+		"policy_id":            1,
+		"duration_ms":          5000,
+		"severity":             "warning",
+		"critical_duration_ms": 8000,
+		"threshold_duration":   600,
+		"runbook_url":          "",
+		"enabled":              true,
+	})
+
+	require.Len(t, input.Terms, 2)
+	require.Equal(t, alerts.NrqlConditionPriorities.Critical, input.Terms[0].Priority)
+	require.Equal(t, float64(8000), *input.Terms[0].Threshold)
+	require.Equal(t, alerts.NrqlConditionPriorities.Warning, input.Terms[1].Priority)
+	require.Equal(t, float64(5000), *input.Terms[1].Threshold)
+	require.Equal(t, 600, input.Terms[1].ThresholdDuration)
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_AlertSeverity(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+
+	diff := func(alert map[string]interface{}) error {
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":      "foo",
+			"type":      "SIMPLE",
+			"frequency": 5,
+			"status":    "ENABLED",
+			"locations": []interface{}{"AWS_US_EAST_1"},
+			"uri":       "https://example.com",
+			"alert":     []interface{}{alert},
+		}), &ProviderConfig{})
+		return err
+	}
+
+	require.NoError(t, diff(map[string]interface{}{"policy_id": 1, "duration_ms": 5000}))
+	require.NoError(t, diff(map[string]interface{}{"policy_id": 1, "duration_ms": 5000, "severity": "warning", "critical_duration_ms": 8000}))
+
+	err := diff(map[string]interface{}{"policy_id": 1, "duration_ms": 5000, "severity": "warning"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "critical_duration_ms is required with a warning severity")
+
+	err = diff(map[string]interface{}{"policy_id": 1, "duration_ms": 5000, "severity": "warning", "critical_duration_ms": 5000})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be greater than the warning duration_ms")
+
+	err = diff(map[string]interface{}{"policy_id": 1, "duration_ms": 5000, "critical_duration_ms": 8000})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "can only be set with a warning severity")
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_AlertAggregation(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+
+	diff := func(alert map[string]interface{}) error {
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":      "foo",
+			"type":      "SIMPLE",
+			"frequency": 5,
+			"status":    "ENABLED",
+			"locations": []interface{}{"AWS_US_EAST_1"},
+			"uri":       "https://example.com",
+			"alert":     []interface{}{alert},
+		}), &ProviderConfig{})
+		return err
+	}
+
+	require.NoError(t, diff(map[string]interface{}{"policy_id": 1, "duration_ms": 5000, "aggregation_window": 300, "threshold_duration": 600, "aggregation_method": "CADENCE"}))
+
+	err := diff(map[string]interface{}{"policy_id": 1, "duration_ms": 5000, "aggregation_window": 120, "threshold_duration": 300})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "alert.0.threshold_duration (300) must be a multiple of alert.0.aggregation_window (120)")
+}
+
+func TestExpandSyntheticsMonitorAlertCreateInput_Aggregation(t *testing.T) {
+	input := expandSyntheticsMonitorAlertCreateInput("abc-123", "foo", map[string]interface{}{
+		"policy_id":          1,
+		"duration_ms":        5000,
+		"severity":           "critical",
+		"threshold_duration": 600,
+		"aggregation_window": 300,
+		"aggregation_method": "EVENT_TIMER",
+		"runbook_url":        "",
+		"enabled":            true,
+	})
+
+	require.NotNil(t, input.Signal)
+	require.Equal(t, 300, *input.Signal.AggregationWindow)
+	require.Equal(t, alerts.NrqlConditionAggregationMethodTypes.EventTimer, *input.Signal.AggregationMethod)
+}
+
+func TestResourceNewRelicSyntheticsMonitorDelete_DeletesAlertCondition(t *testing.T) {
+	var requests []string
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "alertsConditionDelete") {
+			requests = append(requests, "condition")
+			_, _ = w.Write([]byte(`{"data":{"alertsConditionDelete":{"id":"42"}}}`))
+			return
+		}
+		require.Equal(t, http.MethodDelete, r.Method)
+		requests = append(requests, "monitor")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"alert": []interface{}{
+			map[string]interface{}{"policy_id": 1, "duration_ms": 5000},
+		},
+	})
+	d.SetId("abc-123")
+	require.NoError(t, d.Set("alert", []interface{}{
+		map[string]interface{}{"policy_id": 1, "duration_ms": 5000, "threshold_duration": 300, "enabled": true, "condition_id": "42"},
+	}))
+
+	diags := resourceNewRelicSyntheticsMonitorDelete(context.Background(), d, providerConfig)
+	require.False(t, diags.HasError())
+	require.Equal(t, []string{"condition", "monitor"}, requests)
+}
+
+func TestSyncSyntheticsMonitorAlertChannels(t *testing.T) {
+	var requests []string
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/alerts_channels.json":
+			_, _ = w.Write([]byte(`{"channels":[{"id":10,"links":{"policy_ids":[1]}},{"id":11,"links":{"policy_ids":[]}}]}`))
+		case r.Method == http.MethodPut:
+			requests = append(requests, "associate "+r.URL.Query().Get("policy_id")+" "+r.URL.Query().Get("channel_ids"))
+			_, _ = w.Write([]byte(`{"policy":{}}`))
+		case r.Method == http.MethodDelete:
+			requests = append(requests, "dissociate "+r.URL.Query().Get("policy_id")+" "+r.URL.Query().Get("channel_id"))
+			_, _ = w.Write([]byte(`{"channel":{}}`))
+		}
+	})
+	client := providerConfig.NewClient
+
+	oldCfg := map[string]interface{}{"policy_id": 1, "notification_channel_ids": schema.NewSet(schema.HashInt, []interface{}{10})}
+	newCfg := map[string]interface{}{"policy_id": 1, "notification_channel_ids": schema.NewSet(schema.HashInt, []interface{}{11})}
+
+	require.NoError(t, syncSyntheticsMonitorAlertChannels(context.Background(), client, oldCfg, newCfg))
+	require.Equal(t, []string{"associate 1 11", "dissociate 1 10"}, requests)
+
+	// Removing the block dissociates every channel.
+	requests = nil
+	require.NoError(t, syncSyntheticsMonitorAlertChannels(context.Background(), client, newCfg, nil))
+	require.Equal(t, []string{"dissociate 1 11"}, requests)
+
+	newCfg["notification_channel_ids"] = schema.NewSet(schema.HashInt, []interface{}{12})
+	err := syncSyntheticsMonitorAlertChannels(context.Background(), client, nil, newCfg)
+	require.EqualError(t, err, "alert.0.notification_channel_ids: notification channel 12 does not exist")
+
+	// Only the channels still associated with the policy are read back.
+	newCfg["notification_channel_ids"] = schema.NewSet(schema.HashInt, []interface{}{10, 11})
+	channels, err := readSyntheticsMonitorAlertChannels(context.Background(), client, newCfg)
+	require.NoError(t, err)
+	require.Equal(t, []int{10}, channels)
+}
+
+func TestListSyntheticsMonitorAlertConditionIDs(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/alerts_policies.json":
+			_, _ = w.Write([]byte(`{"policies":[{"id":1,"name":"foo"},{"id":2,"name":"bar"}]}`))
+		case "/alerts_synthetics_conditions.json":
+			if r.URL.Query().Get("policy_id") == "1" {
+				_, _ = w.Write([]byte(`{"synthetics_conditions":[{"id":10,"monitor_id":"abc-123"},{"id":11,"monitor_id":"def-456"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"synthetics_conditions":[]}`))
+		case "/alerts_location_failure_conditions/policies/2.json":
+			_, _ = w.Write([]byte(`{"location_failure_conditions":[{"id":20,"entities":["abc-123"]}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"location_failure_conditions":[]}`))
+		}
+	})
+
+	ids, err := listSyntheticsMonitorAlertConditionIDs(context.Background(), providerConfig.NewClient, "abc-123")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1:10", "2:20"}, ids)
+}
+
+func TestWaitForSyntheticsMonitorStatus(t *testing.T) {
+	calls := 0
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		calls++
+		if calls < 2 {
+			_, _ = w.Write([]byte(`{"id":"abc-123","status":"DISABLED"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"abc-123","status":"ENABLED"}`))
+	})
+
+	err := waitForSyntheticsMonitorStatus(context.Background(), providerConfig.NewClient, "abc-123", synthetics.MonitorStatus.Enabled, 10*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func TestWaitForSyntheticsMonitorStatus_Timeout(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"abc-123","status":"DISABLED"}`))
+	})
+
+	err := waitForSyntheticsMonitorStatus(context.Background(), providerConfig.NewClient, "abc-123", synthetics.MonitorStatus.Enabled, time.Second)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "to become ENABLED")
+}
+
+func TestAccNewRelicSyntheticsMonitor_MissingLocations(t *testing.T) {
+	avoidEmptyAccountID()
+	expectedErrorMsg := regexp.MustCompile(`locations must be set on the monitor or through default_synthetics_locations`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "newrelic_synthetics_monitor" "foo" {
+	name      = "tf-test-missing-locations"
+	type      = "SIMPLE"
+	frequency = 5
+	status    = "ENABLED"
+	uri       = "https://example.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: expectedErrorMsg,
+			},
+		},
+	})
+}
+
+func TestAccNewRelicSyntheticsMonitor_MissingFrequency(t *testing.T) {
+	avoidEmptyAccountID()
+	expectedErrorMsg := regexp.MustCompile(`frequency must be set on the monitor or through default_frequency`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "newrelic_synthetics_monitor" "foo" {
+	name      = "tf-test-missing-frequency"
+	type      = "SIMPLE"
+	status    = "ENABLED"
+	locations = ["AWS_US_EAST_1"]
+	uri       = "https://example.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: expectedErrorMsg,
+			},
+		},
+	})
+}
+
+func TestAccNewRelicSyntheticsMonitor_InvalidFrequency(t *testing.T) {
+	avoidEmptyAccountID()
+	expectedErrorMsg := regexp.MustCompile(`expected frequency to be one of \[1 5 10 15 30 60 360 720 1440\], got 2`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		IsUnitTest: true,
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "newrelic_synthetics_monitor" "foo" {
+	name      = "tf-test-invalid-frequency"
+	type      = "SIMPLE"
+	frequency = 2
+	status    = "ENABLED"
+	locations = ["AWS_US_EAST_1"]
+	uri       = "https://example.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: expectedErrorMsg,
+			},
+		},
+	})
+}
+
+func TestReadSyntheticsMonitorStruct_TypeOptions(t *testing.T) {
+	cases := []struct {
+		monitorType string
+		readBack    []string
+	}{
+		{"SIMPLE", []string{"validation_string", "verify_ssl", "bypass_head_request", "treat_redirect_as_failure"}},
+		{"BROWSER", []string{"validation_string", "verify_ssl"}},
+		{"SCRIPT_API", nil},
+		{"SCRIPT_BROWSER", nil},
+		{"CERT_CHECK", nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.monitorType, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+				"name":                      "foo",
+				"type":                      c.monitorType,
+				"locations":                 []interface{}{"AWS_US_EAST_1"},
+				"validation_string":         "ok",
+				"verify_ssl":                true,
+				"bypass_head_request":       true,
+				"treat_redirect_as_failure": true,
+			})
+
+			monitor := testSyntheticsMonitor()
+			monitor.Type = synthetics.MonitorType(c.monitorType)
+			monitor.Options = synthetics.MonitorOptions{}
+			readSyntheticsMonitorStruct(monitor, d)
+
+			for option, configured := range map[string]interface{}{
+				"validation_string":         "ok",
+				"verify_ssl":                true,
+				"bypass_head_request":       true,
+				"treat_redirect_as_failure": true,
+			} {
+				if stringInSlice(c.readBack, option) {
+					require.NotEqual(t, configured, d.Get(option), option)
+				} else {
+					require.Equal(t, configured, d.Get(option), option)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildSyntheticsMonitorStruct_MutedSLAThreshold(t *testing.T) {
+	payloads := map[string]synthetics.Monitor{}
+	for _, status := range []string{"ENABLED", "MUTED"} {
+		d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+			"name":          "foo",
+			"type":          "SIMPLE",
+			"status":        status,
+			"locations":     []interface{}{"AWS_US_EAST_1"},
+			"uri":           "https://example.com",
+			"sla_threshold": 3.5,
+		})
+
+		payloads[status] = buildSyntheticsMonitorStruct(d, nil)
+	}
+
+	require.Equal(t, 3.5, payloads["MUTED"].SLAThreshold)
+	require.Equal(t, synthetics.MonitorStatus.Muted, payloads["MUTED"].Status)
+
+	// Only the status differs.
+	muted := payloads["MUTED"]
+	muted.Status = payloads["ENABLED"].Status
+	require.Equal(t, payloads["ENABLED"], muted)
+}
+
+func TestSyntheticsMonitorSLAThresholdsEqual(t *testing.T) {
+	require.True(t, syntheticsMonitorSLAThresholdsEqual("7", "7.0"))
+	require.True(t, syntheticsMonitorSLAThresholdsEqual("6.9999999", "7"))
+	require.True(t, syntheticsMonitorSLAThresholdsEqual("7.0000001", "7"))
+	require.True(t, syntheticsMonitorSLAThresholdsEqual("0.1", "0.10000000000000002"))
+	require.False(t, syntheticsMonitorSLAThresholdsEqual("7", "7.5"))
+	require.False(t, syntheticsMonitorSLAThresholdsEqual("7", "7.001"))
+	require.False(t, syntheticsMonitorSLAThresholdsEqual("", "7"))
+}
+
+func TestReadSyntheticsMonitorStruct_SLAThresholdPrecision(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":          "foo",
+		"type":          "SIMPLE",
+		"locations":     []interface{}{"AWS_US_EAST_1"},
+		"uri":           "https://example.com",
+		"sla_threshold": 7.0,
+	})
+
+	monitor := testSyntheticsMonitor()
+	monitor.SLAThreshold = 6.9999999
+	readSyntheticsMonitorStruct(monitor, d)
+	require.Equal(t, 7.0, d.Get("sla_threshold"))
+
+	monitor.SLAThreshold = 2.25
+	readSyntheticsMonitorStruct(monitor, d)
+	require.Equal(t, 2.25, d.Get("sla_threshold"))
+}
+
+// testSyntheticsMonitorCreateDiff plans a new monitor from config the way
+// Terraform does, through SimpleDiff and with the raw config that
+// CustomizeDiff reads through GetRawConfig.
+func testSyntheticsMonitorCreateDiff(t *testing.T, config map[string]interface{}, providerConfig *ProviderConfig) (*terraform.InstanceDiff, error) {
+	t.Helper()
+
+	r := resourceNewRelicSyntheticsMonitor()
+
+	b, err := json.Marshal(config)
+	require.NoError(t, err)
+
+	rawConfig, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+	require.NoError(t, err)
+
+	return r.SimpleDiff(context.Background(), &terraform.InstanceState{RawConfig: rawConfig}, terraform.NewResourceConfigRaw(config), providerConfig)
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_LocationGroup(t *testing.T) {
+	providerConfig := &ProviderConfig{
+		SyntheticsLocationGroups: map[string][]string{
+			"us": {"AWS_US_EAST_1", "AWS_US_WEST_1"},
+		},
+	}
+
+	config := map[string]interface{}{
+		"name":           "foo",
+		"type":           "SIMPLE",
+		"frequency":      5,
+		"status":         "ENABLED",
+		"uri":            "https://example.com",
+		"location_group": "us",
+	}
+
+	diff, err := testSyntheticsMonitorCreateDiff(t, config, providerConfig)
+	require.NoError(t, err)
+	require.Equal(t, "2", diff.Attributes["locations.#"].New)
+
+	config["location_group"] = "eu"
+	_, err = testSyntheticsMonitorCreateDiff(t, config, providerConfig)
+	require.EqualError(t, err, `synthetics location group "eu" is not defined in the provider configuration`)
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_LocationsByWorkspace(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+	providerConfig := &ProviderConfig{Workspace: "prod", ValidateLocationsOffline: true}
+
+	config := map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"frequency": 5,
+		"status":    "ENABLED",
+		"uri":       "https://example.com",
+		"locations": []interface{}{"AWS_US_EAST_1"},
+		"locations_by_workspace": []interface{}{
+			map[string]interface{}{"workspace": "prod", "locations": []interface{}{"AWS_US_EAST_1", "AWS_US_WEST_1", "AWS_EU_WEST_1"}},
+		},
+	}
+
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), providerConfig)
+	require.NoError(t, err)
+	require.Equal(t, "3", diff.Attributes["locations.#"].New)
+
+	providerConfig.Workspace = "staging"
+	diff, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), providerConfig)
+	require.NoError(t, err)
+	require.Equal(t, "1", diff.Attributes["locations.#"].New)
+
+	config["locations_by_workspace"] = []interface{}{
+		map[string]interface{}{"workspace": "prod", "locations": []interface{}{"AWS_US_EAST_1"}},
+		map[string]interface{}{"workspace": "dev", "locations": []interface{}{"AWS_MARS_1"}},
+	}
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), providerConfig)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `locations_by_workspace "dev": unknown synthetics locations: AWS_MARS_1`)
+
+	config["locations_by_workspace"] = []interface{}{
+		map[string]interface{}{"workspace": "prod", "locations": []interface{}{"AWS_US_EAST_1"}},
+		map[string]interface{}{"workspace": "prod", "locations": []interface{}{"AWS_US_WEST_1"}},
+	}
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), providerConfig)
+	require.EqualError(t, err, `locations_by_workspace: workspace "prod" has more than one block`)
+}
+
+func TestSyntheticsMonitorNamePrefix(t *testing.T) {
+	providerConfig := &ProviderConfig{MonitorNamePrefix: "checkout-prod-"}
+
+	require.Equal(t, "checkout-prod-home", expandSyntheticsMonitorName("home", providerConfig))
+	require.Equal(t, "checkout-prod-home", expandSyntheticsMonitorName("checkout-prod-home", providerConfig))
+	require.Equal(t, "home", expandSyntheticsMonitorName("home", &ProviderConfig{}))
+
+	require.Equal(t, "home", flattenSyntheticsMonitorName("home", "checkout-prod-home", providerConfig))
+	require.Equal(t, "checkout-prod-home", flattenSyntheticsMonitorName("checkout-prod-home", "checkout-prod-home", providerConfig))
+	require.Equal(t, "home", flattenSyntheticsMonitorName("", "checkout-prod-home", providerConfig))
+	require.Equal(t, "renamed", flattenSyntheticsMonitorName("home", "renamed", providerConfig))
+	require.Equal(t, "checkout-prod-home", flattenSyntheticsMonitorName("home", "checkout-prod-home", &ProviderConfig{}))
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":      "home",
+		"type":      "SIMPLE",
+		"frequency": 5,
+		"status":    "ENABLED",
+		"locations": []interface{}{"AWS_US_EAST_1"},
+		"uri":       "https://example.com",
+	})
+	require.Equal(t, "checkout-prod-home", buildSyntheticsMonitorStruct(d, providerConfig).Name)
+}
+
+func TestGetSyntheticsMonitorNextRunAt(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	lastRun := now.Add(-2 * time.Minute).UnixMilli()
+
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"nrql":{"results":[{"lastRun":` + strconv.FormatInt(lastRun, 10) + `}]}}}}}`))
+	})
+
+	next, err := getSyntheticsMonitorNextRunAt(context.Background(), providerConfig.NewClient, 123, "abc-123", 5, now)
+	require.NoError(t, err)
+	require.Equal(t, "2022-03-01T12:03:00Z", next)
+
+	// An overdue monitor isn't running on schedule.
+	next, err = getSyntheticsMonitorNextRunAt(context.Background(), providerConfig.NewClient, 123, "abc-123", 1, now)
+	require.NoError(t, err)
+	require.Empty(t, next)
+
+	providerConfig = testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"nrql":{"results":[{"lastRun":null}]}}}}}`))
+	})
+
+	next, err = getSyntheticsMonitorNextRunAt(context.Background(), providerConfig.NewClient, 123, "abc-123", 5, now)
+	require.NoError(t, err)
+	require.Empty(t, next)
+}
+
+func TestRetrySyntheticsRateLimited(t *testing.T) {
+	calls := 0
+	err := retrySyntheticsRateLimited(context.Background(), time.Minute, func() error {
+		calls++
+		if calls < 3 {
+			return nrErrors.NewMaxRetriesReached("429 response returned")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	calls = 0
+	err = retrySyntheticsRateLimited(context.Background(), time.Minute, func() error {
+		calls++
+		return nrErrors.NewNotFound("monitor not found")
+	})
+	require.IsType(t, &nrErrors.NotFound{}, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestBuildSyntheticsMonitorStruct_AllowSelfSignedUntil(t *testing.T) {
+	config := map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"locations": []interface{}{"AWS_US_EAST_1"},
+		"uri":       "https://internal.example.com",
+		"ssl": []interface{}{
+			map[string]interface{}{"verify_certificate": true},
+		},
+		"allow_self_signed_until": time.Now().Add(time.Hour).Format(time.RFC3339),
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, config)
+	monitor := buildSyntheticsMonitorStruct(d, nil)
+	require.False(t, monitor.Options.VerifySSL)
+	require.Len(t, syntheticsMonitorSelfSignedGraceDiags(d), 1)
+
+	// The disabled validation doesn't read back as a diff during the grace
+	// period.
+	readSyntheticsMonitorStruct(&monitor, d)
+	require.Equal(t, true, d.Get("ssl.0.verify_certificate"))
+
+	config["allow_self_signed_until"] = time.Now().Add(-time.Hour).Format(time.RFC3339)
+	d = schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, config)
+	require.True(t, buildSyntheticsMonitorStruct(d, nil).Options.VerifySSL)
+	require.Empty(t, syntheticsMonitorSelfSignedGraceDiags(d))
+
+	readSyntheticsMonitorStruct(&monitor, d)
+	require.Equal(t, false, d.Get("ssl.0.verify_certificate"))
+}
+
+func TestWaitForSyntheticsMonitorFirstCheck(t *testing.T) {
+	response := `{"data":{"actor":{"account":{"nrql":{"results":[{"result":"SUCCESS","error":null}]}}}}}`
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.Contains(t, string(body), "WHERE monitorId = 'abc-123'")
+
+		_, _ = w.Write([]byte(response))
+	})
+
+	err := waitForSyntheticsMonitorFirstCheck(context.Background(), providerConfig.NewClient, 123, "abc-123", time.Minute)
+	require.NoError(t, err)
+
+	response = `{"data":{"actor":{"account":{"nrql":{"results":[{"result":"FAILED","error":"Connection refused"}]}}}}}`
+	err = waitForSyntheticsMonitorFirstCheck(context.Background(), providerConfig.NewClient, 123, "abc-123", time.Minute)
+	require.EqualError(t, err, "the first check of synthetics monitor abc-123 returned FAILED: Connection refused")
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_VerifyOnCreate(t *testing.T) {
+	r := resourceNewRelicSyntheticsMonitor()
+
+	config := map[string]interface{}{
+		"name":             "foo",
+		"type":             "SIMPLE",
+		"frequency":        5,
+		"status":           "ENABLED",
+		"locations":        []interface{}{"AWS_US_EAST_1"},
+		"uri":              "https://example.com",
+		"verify_on_create": true,
+	}
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &ProviderConfig{})
+	require.NoError(t, err)
+
+	config["status"] = "DISABLED"
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), &ProviderConfig{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "verify_on_create requires an ENABLED or MUTED monitor")
+}
+
+func TestResourceNewRelicSyntheticsMonitorCustomizeDiff_DefaultSLAThreshold(t *testing.T) {
+	config := map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"frequency": 5,
+		"status":    "ENABLED",
+		"locations": []interface{}{"AWS_US_EAST_1"},
+		"uri":       "https://example.com",
+	}
+
+	diff, err := testSyntheticsMonitorCreateDiff(t, config, &ProviderConfig{})
+	require.NoError(t, err)
+	require.Equal(t, "7", diff.Attributes["sla_threshold"].New)
+
+	diff, err = testSyntheticsMonitorCreateDiff(t, config, &ProviderConfig{DefaultSyntheticsSLAThreshold: 2.5})
+	require.NoError(t, err)
+	require.Equal(t, "2.5", diff.Attributes["sla_threshold"].New)
+
+	config["sla_threshold"] = 4
+	diff, err = testSyntheticsMonitorCreateDiff(t, config, &ProviderConfig{DefaultSyntheticsSLAThreshold: 2.5})
+	require.NoError(t, err)
+	require.Equal(t, "4", diff.Attributes["sla_threshold"].New)
+}
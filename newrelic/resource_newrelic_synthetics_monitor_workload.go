@@ -0,0 +1,100 @@
+package newrelic
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/common"
+	"github.com/newrelic/newrelic-client-go/pkg/workloads"
+)
+
+// getSyntheticsMonitorWorkload fetches the workload with the given GUID, or
+// returns nil when it doesn't exist. The workload's account is read from its
+// GUID.
+func getSyntheticsMonitorWorkload(ctx context.Context, client *nr.NewRelic, workloadGUID string) (*workloads.Workload, error) {
+	accountID, err := entityGUIDAccountID(workloadGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	workload, err := client.Workloads.GetWorkloadWithContext(ctx, accountID, workloadGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if workload.GUID == "" {
+		return nil, nil
+	}
+
+	return workload, nil
+}
+
+func workloadHasEntity(workload *workloads.Workload, entityGUID string) bool {
+	for _, e := range workload.Entities {
+		if e.GUID == entityGUID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setSyntheticsMonitorWorkloadMembership adds the monitor's entity to the
+// workload, or removes it. The workload's other entities and its entity
+// search queries are left as they are.
+func setSyntheticsMonitorWorkloadMembership(ctx context.Context, client *nr.NewRelic, workloadGUID string, monitorGUID common.EntityGUID, member bool) error {
+	workload, err := getSyntheticsMonitorWorkload(ctx, client, workloadGUID)
+	if err != nil {
+		return err
+	}
+
+	if workload == nil {
+		return fmt.Errorf("workload %s does not exist", workloadGUID)
+	}
+
+	if workloadHasEntity(workload, string(monitorGUID)) == member {
+		return nil
+	}
+
+	guids := []common.EntityGUID{}
+	for _, e := range workload.Entities {
+		if e.GUID != string(monitorGUID) {
+			guids = append(guids, common.EntityGUID(e.GUID))
+		}
+	}
+
+	if member {
+		log.Printf("[INFO] Adding New Relic Synthetics monitor %s to workload %s", monitorGUID, workloadGUID)
+		guids = append(guids, monitorGUID)
+	} else {
+		log.Printf("[INFO] Removing New Relic Synthetics monitor %s from workload %s", monitorGUID, workloadGUID)
+	}
+
+	if _, err := client.Workloads.WorkloadUpdateWithContext(ctx, common.EntityGUID(workloadGUID), workloads.WorkloadUpdateInput{EntityGUIDs: guids}); err != nil {
+		return fmt.Errorf("error updating workload %s: %w", workloadGUID, err)
+	}
+
+	return nil
+}
+
+// entityGUIDAccountID returns the account ID encoded in an entity GUID, the
+// unpadded base64 encoding of `<accountID>|<domain>|<type>|<id>`.
+func entityGUIDAccountID(guid string) (int, error) {
+	raw, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(guid, "="))
+	if err != nil {
+		return 0, fmt.Errorf("invalid entity GUID %q: %w", guid, err)
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	accountID, err := strconv.Atoi(parts[0])
+	if err != nil || len(parts) != 2 {
+		return 0, fmt.Errorf("invalid entity GUID %q", guid)
+	}
+
+	return accountID, nil
+}
@@ -0,0 +1,67 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testWorkloadGUID is the GUID of workload 1 in account 123.
+const testWorkloadGUID = "MTIzfE5SMXxXT1JLTE9BRHwx"
+
+func TestEntityGUIDAccountID(t *testing.T) {
+	accountID, err := entityGUIDAccountID(testWorkloadGUID)
+	require.NoError(t, err)
+	require.Equal(t, 123, accountID)
+
+	_, err = entityGUIDAccountID("not-a-guid")
+	require.Error(t, err)
+}
+
+func TestSetSyntheticsMonitorWorkloadMembership(t *testing.T) {
+	monitorGUID := syntheticsMonitorGUID(123, "abc-123")
+	var updated []interface{}
+
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if strings.Contains(req.Query, "workloadUpdate") {
+			updated = req.Variables["workload"].(map[string]interface{})["entityGuids"].([]interface{})
+			_, _ = w.Write([]byte(`{"data":{"workloadUpdate":{"guid":"` + testWorkloadGUID + `"}}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"workload":{"collection":{"guid":"` + testWorkloadGUID + `","entities":[{"guid":"OTHER"}]}}}}}}`))
+	})
+
+	err := setSyntheticsMonitorWorkloadMembership(context.Background(), providerConfig.NewClient, testWorkloadGUID, monitorGUID, true)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"OTHER", string(monitorGUID)}, updated)
+
+	// The monitor isn't in the workload, so there's nothing to remove.
+	updated = nil
+	err = setSyntheticsMonitorWorkloadMembership(context.Background(), providerConfig.NewClient, testWorkloadGUID, monitorGUID, false)
+	require.NoError(t, err)
+	require.Nil(t, updated)
+}
+
+func TestSetSyntheticsMonitorWorkloadMembership_MissingWorkload(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"workload":{"collection":null}}}}}`))
+	})
+
+	err := setSyntheticsMonitorWorkloadMembership(context.Background(), providerConfig.NewClient, testWorkloadGUID, syntheticsMonitorGUID(123, "abc-123"), true)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not exist")
+}
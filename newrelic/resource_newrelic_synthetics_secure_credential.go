@@ -2,12 +2,14 @@ package newrelic
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
 )
 
 func resourceNewRelicSyntheticsSecureCredential() *schema.Resource {
@@ -17,7 +19,7 @@ func resourceNewRelicSyntheticsSecureCredential() *schema.Resource {
 		UpdateContext: resourceNewRelicSyntheticsSecureCredentialUpdate,
 		DeleteContext: resourceNewRelicSyntheticsSecureCredentialDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importSyntheticsSecureCredential,
 		},
 		Schema: map[string]*schema.Schema{
 			"key": {
@@ -31,10 +33,18 @@ func resourceNewRelicSyntheticsSecureCredential() *schema.Resource {
 				},
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The secure credential's value.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_source"},
+				Description:  "The secure credential's value.",
+			},
+			"value_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "value_source"},
+				ValidateFunc: validateSecretSource(),
+				Description:  "Where to read the secure credential's value from at apply time, as `env:<NAME>` or `file:<path>`.",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -57,16 +67,67 @@ func resourceNewRelicSyntheticsSecureCredential() *schema.Resource {
 	}
 }
 
+// importSyntheticsSecureCredential imports a secure credential by key. The API
+// never returns credential values, so only the metadata is imported and the
+// first apply afterwards sets the configured value.
+func importSyntheticsSecureCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderConfig).NewClient
+	key := strings.ToUpper(d.Id())
+
+	log.Printf("[INFO] Importing New Relic Synthetics secure credential %s", key)
+
+	sc, err := client.Synthetics.GetSecureCredentialWithContext(ctx, key)
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return nil, fmt.Errorf("secure credential %s not found", key)
+		}
+
+		return nil, err
+	}
+
+	d.SetId(sc.Key)
+	if err := flattenSyntheticsSecureCredential(sc, d); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syntheticsSecureCredentialsCacheKey is the metadata cache key of the secure
+// credentials list, invalidated whenever a credential is added or deleted.
+const syntheticsSecureCredentialsCacheKey = "synthetics-secure-credentials"
+
+// listSyntheticsSecureCredentials lists the secure credentials through the
+// provider's shared metadata cache, so validating many scripts lists them
+// once.
+func listSyntheticsSecureCredentials(ctx context.Context, providerConfig *ProviderConfig) ([]*synthetics.SecureCredential, error) {
+	credentials, err := providerConfig.metadataCache.get(syntheticsSecureCredentialsCacheKey, func() (interface{}, error) {
+		ctx, cancel := providerConfig.metadataFetchContext(ctx)
+		defer cancel()
+
+		return providerConfig.NewClient.Synthetics.GetSecureCredentialsWithContext(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.([]*synthetics.SecureCredential), nil
+}
+
 func resourceNewRelicSyntheticsSecureCredentialCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).NewClient
-	sc := expandSyntheticsSecureCredential(d)
+	sc, err := expandSyntheticsSecureCredential(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Printf("[INFO] Creating New Relic Synthetics secure credential %s", sc.Key)
 
-	sc, err := client.Synthetics.AddSecureCredentialWithContext(ctx, sc.Key, sc.Value, sc.Description)
+	sc, err = client.Synthetics.AddSecureCredentialWithContext(ctx, sc.Key, sc.Value, sc.Description)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	meta.(*ProviderConfig).metadataCache.invalidate(syntheticsSecureCredentialsCacheKey)
 
 	d.SetId(sc.Key)
 	return resourceNewRelicSyntheticsSecureCredentialRead(ctx, d, meta)
@@ -94,9 +155,12 @@ func resourceNewRelicSyntheticsSecureCredentialUpdate(ctx context.Context, d *sc
 	client := meta.(*ProviderConfig).NewClient
 	log.Printf("[INFO] Updating New Relic Synthetics secure credential %s", d.Id())
 
-	sc := expandSyntheticsSecureCredential(d)
+	sc, err := expandSyntheticsSecureCredential(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	_, err := client.Synthetics.UpdateSecureCredentialWithContext(ctx, sc.Key, sc.Value, sc.Description)
+	_, err = client.Synthetics.UpdateSecureCredentialWithContext(ctx, sc.Key, sc.Value, sc.Description)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -112,6 +176,7 @@ func resourceNewRelicSyntheticsSecureCredentialDelete(ctx context.Context, d *sc
 	if err := client.Synthetics.DeleteSecureCredentialWithContext(ctx, d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
+	meta.(*ProviderConfig).metadataCache.invalidate(syntheticsSecureCredentialsCacheKey)
 
 	return nil
 }
@@ -0,0 +1,48 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportSyntheticsSecureCredential(t *testing.T) {
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secure-credentials/API_TOKEN" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{
+			"key": "API_TOKEN",
+			"description": "Token for the status API",
+			"createdAt": "2022-03-01T10:00:00.000+0000",
+			"lastUpdated": "2022-03-02T10:00:00.000+0000"
+		}`))
+	})
+
+	r := resourceNewRelicSyntheticsSecureCredential()
+
+	d := r.TestResourceData()
+	d.SetId("api_token")
+
+	imported, err := importSyntheticsSecureCredential(context.Background(), d, providerConfig)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, "API_TOKEN", imported[0].Id())
+	require.Equal(t, "Token for the status API", imported[0].Get("description"))
+	require.Equal(t, "2022-03-01T10:00:00Z", imported[0].Get("created_at"))
+	require.Equal(t, "2022-03-02T10:00:00Z", imported[0].Get("last_updated"))
+	require.Empty(t, imported[0].Get("value"))
+
+	d = r.TestResourceData()
+	d.SetId("MISSING")
+
+	_, err = importSyntheticsSecureCredential(context.Background(), d, providerConfig)
+	require.EqualError(t, err, "secure credential MISSING not found")
+}
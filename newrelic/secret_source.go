@@ -0,0 +1,55 @@
+package newrelic
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Secret sources let sensitive attributes be read from outside the
+// configuration at apply time. A source is written as `<scheme>:<reference>`:
+//
+//	env:<NAME>   the value of the environment variable NAME
+//	file:<path>  the contents of the file, without a trailing newline
+var secretSourceSchemes = []string{"env", "file"}
+
+func validateSecretSource() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		scheme, ref, found := strings.Cut(v, ":")
+		if !found || ref == "" || !stringInSlice(secretSourceSchemes, scheme) {
+			es = append(es, fmt.Errorf("expected %s to be env:<NAME> or file:<path>, got %q", k, v))
+		}
+
+		return
+	}
+}
+
+// resolveSecretSource returns the value a secret source points to.
+func resolveSecretSource(source string) (string, error) {
+	scheme, ref, _ := strings.Cut(source, ":")
+
+	switch scheme {
+	case "env":
+		v, ok := os.LookupEnv(ref)
+		if !ok {
+			return "", fmt.Errorf("error resolving secret source %s: environment variable %s is not set", source, ref)
+		}
+		return v, nil
+	case "file":
+		b, err := os.ReadFile(ref)
+		if err != nil {
+			return "", fmt.Errorf("error resolving secret source %s: %w", source, err)
+		}
+		return strings.TrimSuffix(strings.TrimSuffix(string(b), "\n"), "\r"), nil
+	}
+
+	return "", fmt.Errorf("error resolving secret source %s: unsupported scheme %q", source, scheme)
+}
@@ -0,0 +1,45 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSecretSource(t *testing.T) {
+	t.Setenv("NEW_RELIC_TEST_SECRET", "from-env")
+
+	file := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(file, []byte("from-file\n"), 0600))
+
+	v, err := resolveSecretSource("env:NEW_RELIC_TEST_SECRET")
+	require.NoError(t, err)
+	require.Equal(t, "from-env", v)
+
+	v, err = resolveSecretSource("file:" + file)
+	require.NoError(t, err)
+	require.Equal(t, "from-file", v)
+
+	_, err = resolveSecretSource("env:NEW_RELIC_TEST_SECRET_UNSET")
+	require.Error(t, err)
+
+	_, err = resolveSecretSource("file:" + filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestValidateSecretSource(t *testing.T) {
+	for _, v := range []string{"env:SECRET", "file:/etc/secret"} {
+		_, errs := validateSecretSource()(v, "value_source")
+		require.Empty(t, errs, v)
+	}
+
+	for _, v := range []string{"SECRET", "env:", "vault:secret/data", ""} {
+		_, errs := validateSecretSource()(v, "value_source")
+		require.NotEmpty(t, errs, v)
+	}
+}
@@ -8,17 +8,26 @@ import (
 	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
 )
 
-func expandSyntheticsSecureCredential(d *schema.ResourceData) *synthetics.SecureCredential {
+func expandSyntheticsSecureCredential(d *schema.ResourceData) (*synthetics.SecureCredential, error) {
 	key := d.Get("key").(string)
 	key = strings.ToUpper(key)
 
+	value := d.Get("value").(string)
+	if src, ok := d.GetOk("value_source"); ok {
+		v, err := resolveSecretSource(src.(string))
+		if err != nil {
+			return nil, err
+		}
+		value = v
+	}
+
 	sc := synthetics.SecureCredential{
 		Key:         key,
-		Value:       d.Get("value").(string),
+		Value:       value,
 		Description: d.Get("description").(string),
 	}
 
-	return &sc
+	return &sc, nil
 }
 
 func flattenSyntheticsSecureCredential(sc *synthetics.SecureCredential, d *schema.ResourceData) error {
@@ -28,8 +37,10 @@ func flattenSyntheticsSecureCredential(sc *synthetics.SecureCredential, d *schem
 	createdAt := time.Time(*sc.CreatedAt).Format(time.RFC3339)
 	_ = d.Set("created_at", createdAt)
 
-	lastUpdated := time.Time(*sc.CreatedAt).Format(time.RFC3339)
-	_ = d.Set("last_updated", lastUpdated)
+	if sc.LastUpdated != nil {
+		lastUpdated := time.Time(*sc.LastUpdated).Format(time.RFC3339)
+		_ = d.Set("last_updated", lastUpdated)
+	}
 
 	return nil
 }
@@ -0,0 +1,51 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+// syntheticsMonitorCache holds every monitor in the account, listed once per
+// provider instance when batch_synthetics_monitor_reads is enabled. Each entry
+// is handed out at most once, so only the first read of a monitor (the
+// refresh) is served from the listing; reads following a create or update go
+// to the API and see the monitor's current state.
+type syntheticsMonitorCache struct {
+	mu       sync.Mutex
+	loaded   bool
+	monitors map[string]*synthetics.Monitor
+}
+
+// take returns the listed monitor with the given ID and removes it from the
+// cache. The account's monitors are listed on first use; if listing fails,
+// the cache stays empty and callers fall back to reading monitors one by one.
+func (c *syntheticsMonitorCache) take(ctx context.Context, client *nr.NewRelic, monitorID string) (*synthetics.Monitor, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		c.loaded = true
+
+		monitors, err := client.Synthetics.ListMonitorsWithContext(ctx)
+		if err != nil {
+			log.Printf("[WARN] Listing New Relic Synthetics monitors failed, reading monitors individually: %s", err)
+			return nil, false
+		}
+
+		c.monitors = make(map[string]*synthetics.Monitor, len(monitors))
+		for _, m := range monitors {
+			c.monitors[m.ID] = m
+		}
+	}
+
+	m, ok := c.monitors[monitorID]
+	if ok {
+		delete(c.monitors, monitorID)
+	}
+
+	return m, ok
+}
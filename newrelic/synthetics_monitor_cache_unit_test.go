@@ -0,0 +1,68 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsMonitorCache_Take(t *testing.T) {
+	listCalls := 0
+	getCalls := 0
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v4/monitors":
+			listCalls++
+			_, _ = w.Write([]byte(`{"monitors":[{"id":"abc-123","name":"foo"},{"id":"def-456","name":"bar"}]}`))
+		case "/v4/monitors/abc-123":
+			getCalls++
+			_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo-updated"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	providerConfig.BatchSyntheticsMonitorReads = true
+	ctx := context.Background()
+
+	m, err := getSyntheticsMonitor(ctx, providerConfig, providerConfig.NewClient, "abc-123")
+	require.NoError(t, err)
+	require.Equal(t, "foo", m.Name)
+
+	m, err = getSyntheticsMonitor(ctx, providerConfig, providerConfig.NewClient, "def-456")
+	require.NoError(t, err)
+	require.Equal(t, "bar", m.Name)
+	require.Equal(t, 1, listCalls)
+	require.Equal(t, 0, getCalls)
+
+	// Entries are only served once; the next read goes to the API.
+	m, err = getSyntheticsMonitor(ctx, providerConfig, providerConfig.NewClient, "abc-123")
+	require.NoError(t, err)
+	require.Equal(t, "foo-updated", m.Name)
+	require.Equal(t, 1, listCalls)
+	require.Equal(t, 1, getCalls)
+}
+
+func TestSyntheticsMonitorCache_ListError(t *testing.T) {
+	listCalls := 0
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v4/monitors" {
+			listCalls++
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"abc-123","name":"foo"}`))
+	})
+	providerConfig.BatchSyntheticsMonitorReads = true
+
+	for i := 0; i < 2; i++ {
+		m, err := getSyntheticsMonitor(context.Background(), providerConfig, providerConfig.NewClient, "abc-123")
+		require.NoError(t, err)
+		require.Equal(t, "foo", m.Name)
+	}
+	require.Equal(t, 1, listCalls)
+}
@@ -0,0 +1,103 @@
+package newrelic
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// syntheticsMonitorOperation records a create, update or delete of a
+// Synthetics monitor for the provider's operations summary.
+type syntheticsMonitorOperation struct {
+	Operation     string   `json:"operation"`
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	ChangedFields []string `json:"changed_fields,omitempty"`
+}
+
+// syntheticsMonitorOperationLog accumulates the monitor operations of a run
+// and writes them as JSON to path. Terraform applies resources in parallel,
+// so records are appended under a lock. The SDK has no hook for the end of an
+// apply, so the whole summary is rewritten after each record; the file is
+// complete once the apply finishes.
+type syntheticsMonitorOperationLog struct {
+	mu         sync.Mutex
+	path       string
+	operations []syntheticsMonitorOperation
+}
+
+// record appends op to the log and rewrites the summary file. It is a no-op
+// when no path is configured.
+func (l *syntheticsMonitorOperationLog) record(op syntheticsMonitorOperation) error {
+	if l.path == "" {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.operations = append(l.operations, op)
+
+	out, err := json.MarshalIndent(map[string]interface{}{"operations": l.operations}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial summary.
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), ".newrelic-monitor-operations-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(out); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), l.path)
+}
+
+// recordSyntheticsMonitorOperation adds a monitor operation to the provider's
+// operations summary. The monitor has already been changed at this point, so
+// failing to write the summary is reported as a warning.
+func recordSyntheticsMonitorOperation(providerConfig *ProviderConfig, operation, id, name string, changedFields []string) diag.Diagnostics {
+	err := providerConfig.monitorOperations.record(syntheticsMonitorOperation{
+		Operation:     operation,
+		ID:            id,
+		Name:          name,
+		ChangedFields: changedFields,
+	})
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Could not write the Synthetics monitor operations summary to %s", providerConfig.monitorOperations.path),
+			Detail:   err.Error(),
+		}}
+	}
+
+	return nil
+}
+
+// syntheticsMonitorChangedFields returns the sorted names of the top-level
+// attributes changed by the update being applied.
+func syntheticsMonitorChangedFields(d *schema.ResourceData) []string {
+	fields := []string{}
+	for k, s := range resourceNewRelicSyntheticsMonitor().Schema {
+		if (s.Optional || s.Required) && d.HasChange(k) {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+
+	return fields
+}
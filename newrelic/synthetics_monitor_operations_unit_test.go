@@ -0,0 +1,58 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsMonitorOperationLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "operations.json")
+	l := syntheticsMonitorOperationLog{path: path}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			require.NoError(t, l.record(syntheticsMonitorOperation{Operation: "create", ID: fmt.Sprintf("id-%02d", i), Name: "foo"}))
+		}(i)
+	}
+	wg.Wait()
+
+	require.NoError(t, l.record(syntheticsMonitorOperation{Operation: "update", ID: "id-00", Name: "foo", ChangedFields: []string{"frequency"}}))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var summary struct {
+		Operations []syntheticsMonitorOperation `json:"operations"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &summary))
+	require.Len(t, summary.Operations, 21)
+
+	ids := []string{}
+	for _, op := range summary.Operations[:20] {
+		ids = append(ids, op.ID)
+	}
+	sort.Strings(ids)
+	require.Equal(t, "id-00", ids[0])
+	require.Equal(t, "id-19", ids[19])
+	require.Equal(t, syntheticsMonitorOperation{Operation: "update", ID: "id-00", Name: "foo", ChangedFields: []string{"frequency"}}, summary.Operations[20])
+}
+
+func TestSyntheticsMonitorOperationLog_NoPath(t *testing.T) {
+	l := syntheticsMonitorOperationLog{}
+
+	require.NoError(t, l.record(syntheticsMonitorOperation{Operation: "delete", ID: "abc-123"}))
+	require.Empty(t, l.operations)
+}
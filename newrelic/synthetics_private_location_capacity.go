@@ -0,0 +1,123 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nr "github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/nrdb"
+)
+
+// syntheticsMinionChecksPerMinute is a rough estimate of the checks a single
+// private minion completes per minute. Actual throughput depends on the
+// minion's resources and on how long the checks take, so the capacity check
+// only ever warns.
+const syntheticsMinionChecksPerMinute = 10
+
+// syntheticsPrivateLocationHealth is the recent load and minion count of a
+// private location.
+type syntheticsPrivateLocationHealth struct {
+	Minions         int
+	ChecksPerMinute float64
+}
+
+// getSyntheticsPrivateLocationHealth queries the minions that reported for a
+// private location in the last 15 minutes and the rate of checks it ran in
+// the last hour.
+func getSyntheticsPrivateLocationHealth(ctx context.Context, client *nr.NewRelic, accountID int, location string) (*syntheticsPrivateLocationHealth, error) {
+	location = strings.ReplaceAll(location, "'", "\\'")
+
+	minions, err := client.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(fmt.Sprintf("SELECT uniqueCount(minionId) AS 'minions' FROM SyntheticsPrivateMinion WHERE minionLocation = '%s' SINCE 15 minutes ago", location)))
+	if err != nil {
+		return nil, err
+	}
+
+	checks, err := client.Nrdb.QueryWithContext(ctx, accountID, nrdb.NRQL(fmt.Sprintf("SELECT rate(count(*), 1 minute) AS 'checksPerMinute' FROM SyntheticCheck WHERE location = '%s' SINCE 1 hour ago", location)))
+	if err != nil {
+		return nil, err
+	}
+
+	health := &syntheticsPrivateLocationHealth{}
+	if len(minions.Results) > 0 {
+		if v, ok := minions.Results[0]["minions"].(float64); ok {
+			health.Minions = int(v)
+		}
+	}
+	if len(checks.Results) > 0 {
+		if v, ok := checks.Results[0]["checksPerMinute"].(float64); ok {
+			health.ChecksPerMinute = v
+		}
+	}
+
+	return health, nil
+}
+
+// syntheticsMonitorPrivateLocations returns the monitor's locations that
+// aren't public Synthetics locations.
+func syntheticsMonitorPrivateLocations(locations []string) []string {
+	private := []string{}
+	for _, l := range locations {
+		if !stringInSlice(syntheticsPublicLocations, l) {
+			private = append(private, l)
+		}
+	}
+	sort.Strings(private)
+
+	return private
+}
+
+// checkSyntheticsPrivateLocationCapacity warns when running the monitor at
+// its frequency likely exceeds the capacity of the minions of its private
+// locations. The estimate adds one check per frequency minutes to each
+// location's recent load and compares it to the number of minions reporting
+// for the location. Failing to query a location's health is also reported as
+// a warning, since the check is advisory.
+func checkSyntheticsPrivateLocationCapacity(ctx context.Context, d *schema.ResourceData, client *nr.NewRelic, accountID int) diag.Diagnostics {
+	frequency := d.Get("frequency").(int)
+	if frequency <= 0 {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for _, location := range syntheticsMonitorPrivateLocations(expandSyntheticsMonitorLocations(d)) {
+		health, err := getSyntheticsPrivateLocationHealth(ctx, client, accountID, location)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Could not check the minion capacity of private location %s", location),
+				Detail:   err.Error(),
+			})
+			continue
+		}
+
+		required := health.ChecksPerMinute + 1/float64(frequency)
+		needed := int(math.Ceil(required / syntheticsMinionChecksPerMinute))
+
+		log.Printf("[DEBUG] Private location %s runs %.2f checks a minute with %d minions", location, health.ChecksPerMinute, health.Minions)
+
+		if health.Minions == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("No minions reported for private location %s", location),
+				Detail:   fmt.Sprintf("No minion of private location %s reported in the last 15 minutes, so checks of synthetics monitor %q at this location will not run.", location, d.Get("name").(string)),
+			})
+			continue
+		}
+
+		if needed > health.Minions {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Private location %s likely lacks the minions to run synthetics monitor %q", location, d.Get("name").(string)),
+				Detail:   fmt.Sprintf("Private location %s ran %.2f checks a minute over the last hour. Adding a check every %d minutes needs an estimated %d minions at %d checks a minute each, but %d minions reported in the last 15 minutes. Checks may queue or be skipped; add minions or run the monitor less often.", location, health.ChecksPerMinute, frequency, needed, syntheticsMinionChecksPerMinute, health.Minions),
+			})
+		}
+	}
+
+	return diags
+}
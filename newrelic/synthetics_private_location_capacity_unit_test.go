@@ -0,0 +1,59 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsMonitorPrivateLocations(t *testing.T) {
+	locations := syntheticsMonitorPrivateLocations([]string{"AWS_US_EAST_1", "123-private-b", "123-private-a"})
+	require.Equal(t, []string{"123-private-a", "123-private-b"}, locations)
+}
+
+func TestCheckSyntheticsPrivateLocationCapacity(t *testing.T) {
+	minions := "1"
+	providerConfig := testMockProviderConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.Contains(t, string(body), "123-private")
+
+		if strings.Contains(string(body), "SyntheticsPrivateMinion") {
+			_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"nrql":{"results":[{"minions":` + minions + `}]}}}}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"actor":{"account":{"nrql":{"results":[{"checksPerMinute":9.5}]}}}}}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNewRelicSyntheticsMonitor().Schema, map[string]interface{}{
+		"name":      "foo",
+		"type":      "SIMPLE",
+		"frequency": 1,
+		"status":    "ENABLED",
+		"locations": []interface{}{"AWS_US_EAST_1", "123-private"},
+		"uri":       "https://example.com",
+	})
+
+	// 9.5 checks a minute plus one more needs 2 minions.
+	diags := checkSyntheticsPrivateLocationCapacity(context.Background(), d, providerConfig.NewClient, 123)
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Contains(t, diags[0].Summary, "likely lacks the minions")
+
+	minions = "2"
+	require.Empty(t, checkSyntheticsPrivateLocationCapacity(context.Background(), d, providerConfig.NewClient, 123))
+
+	minions = "0"
+	diags = checkSyntheticsPrivateLocationCapacity(context.Background(), d, providerConfig.NewClient, 123)
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Summary, "No minions reported")
+}
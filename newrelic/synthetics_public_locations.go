@@ -0,0 +1,28 @@
+package newrelic
+
+// syntheticsPublicLocations is a snapshot of the public Synthetics location
+// names, used to validate `locations` at plan time when the provider is
+// configured with `validate_locations_offline`. Keep it in sync with the
+// /v1/locations endpoint of the Synthetics API.
+var syntheticsPublicLocations = []string{
+	"AWS_AF_SOUTH_1",
+	"AWS_AP_EAST_1",
+	"AWS_AP_NORTHEAST_1",
+	"AWS_AP_NORTHEAST_2",
+	"AWS_AP_SOUTH_1",
+	"AWS_AP_SOUTHEAST_1",
+	"AWS_AP_SOUTHEAST_2",
+	"AWS_CA_CENTRAL_1",
+	"AWS_EU_CENTRAL_1",
+	"AWS_EU_NORTH_1",
+	"AWS_EU_SOUTH_1",
+	"AWS_EU_WEST_1",
+	"AWS_EU_WEST_2",
+	"AWS_EU_WEST_3",
+	"AWS_ME_SOUTH_1",
+	"AWS_SA_EAST_1",
+	"AWS_US_EAST_1",
+	"AWS_US_EAST_2",
+	"AWS_US_WEST_1",
+	"AWS_US_WEST_2",
+}
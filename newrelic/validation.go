@@ -2,6 +2,7 @@ package newrelic
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -60,6 +61,52 @@ func intInSlice(valid []int) schema.SchemaValidateFunc {
 	}
 }
 
+// intInSliceWarning is like intInSlice, but only warns about values outside of
+// valid. Use it for attributes whose valid values may be withdrawn by the API,
+// so that existing resources keep planning.
+func intInSliceWarning(valid []int) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		v, ok := i.(int)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be int", k))
+			return
+		}
+
+		for _, p := range valid {
+			if v == p {
+				return
+			}
+		}
+
+		ws = append(ws, fmt.Sprintf("expected %s to be one of %v, got %v", k, valid, v))
+		return
+	}
+}
+
+// stringInSliceDeprecated is like validation.StringInSlice, but also warns
+// about values in deprecated, suggesting the mapped replacement. Deprecated
+// values stay valid, so existing resources keep planning.
+func stringInSliceDeprecated(valid []string, deprecated map[string]string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if !stringInSlice(valid, v) {
+			es = append(es, fmt.Errorf("expected %s to be one of %v, got %s", k, valid, v))
+			return
+		}
+
+		if replacement, ok := deprecated[v]; ok {
+			ws = append(ws, fmt.Sprintf("%s %s is deprecated by New Relic, use %s instead", k, v, replacement))
+		}
+
+		return
+	}
+}
+
 // float64AtLeast returns a SchemaValidateFunc which tests if the provided value
 // is of type float64 and is at least min (inclusive)
 func float64AtLeast(min float64) schema.SchemaValidateFunc {
@@ -78,3 +125,27 @@ func float64AtLeast(min float64) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// validatePositiveDuration checks that a string is a positive Go duration,
+// e.g. "30s" or "2m".
+func validatePositiveDuration() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			es = append(es, fmt.Errorf("expected %s to be a duration such as \"30s\" or \"2m\", got %q", k, v))
+			return
+		}
+
+		if d <= 0 {
+			es = append(es, fmt.Errorf("expected %s to be positive, got %s", k, v))
+		}
+
+		return
+	}
+}
@@ -60,6 +60,51 @@ func TestValidationIntInInSlice(t *testing.T) {
 	})
 }
 
+func TestValidationIntInSliceWarning(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: 2,
+			f:   intInSliceWarning([]int{1, 2, 3}),
+		},
+		{
+			val:          4,
+			f:            intInSliceWarning([]int{1, 2, 3}),
+			expectedWarn: regexp.MustCompile(`expected [\w]+ to be one of \[1 2 3\], got 4`),
+		},
+		{
+			val:         "foo",
+			f:           intInSliceWarning([]int{1, 2, 3}),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be int`),
+		},
+	})
+}
+
+func TestValidationStringInSliceDeprecated(t *testing.T) {
+	f := stringInSliceDeprecated([]string{"NEW", "OLD"}, map[string]string{"OLD": "NEW"})
+
+	runTestCases(t, []testCase{
+		{
+			val: "NEW",
+			f:   f,
+		},
+		{
+			val:          "OLD",
+			f:            f,
+			expectedWarn: regexp.MustCompile(`[\w]+ OLD is deprecated by New Relic, use NEW instead`),
+		},
+		{
+			val:         "OTHER",
+			f:           f,
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be one of \[NEW OLD\], got OTHER`),
+		},
+		{
+			val:         1,
+			f:           f,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
 func TestValidationFloat64Gte(t *testing.T) {
 	runTestCases(t, []testCase{
 		{
@@ -147,3 +192,33 @@ func runTestCases(t *testing.T, cases []testCase) {
 		}
 	}
 }
+
+func TestValidationPositiveDuration(t *testing.T) {
+	f := validatePositiveDuration()
+
+	runTestCases(t, []testCase{
+		{
+			val: "30s",
+			f:   f,
+		},
+		{
+			val: "1m30s",
+			f:   f,
+		},
+		{
+			val:         "30",
+			f:           f,
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be a duration such as "30s" or "2m", got "30"`),
+		},
+		{
+			val:         "0s",
+			f:           f,
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be positive, got 0s`),
+		},
+		{
+			val:         30,
+			f:           f,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}